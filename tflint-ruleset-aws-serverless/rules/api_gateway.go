@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const (
+	apiGatewayRestAPIType      = "aws_api_gateway_rest_api"
+	apiGatewayPrivateEndpoint  = "PRIVATE"
+	apiGatewayEndpointBlock    = "endpoint_configuration"
+	apiGatewayEndpointTypeAttr = "types"
+)
+
+// apiGatewayPrivateRestAPIs returns the names of "aws_api_gateway_rest_api"
+// resources with a private endpoint.
+func apiGatewayPrivateRestAPIs(runner tflint.Runner) (map[string]bool, error) {
+	private := make(map[string]bool)
+
+	resources, err := runner.GetResourceContent(apiGatewayRestAPIType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: apiGatewayEndpointBlock,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: apiGatewayEndpointTypeAttr},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, block := range resource.Body.Blocks {
+			attr, ok := block.Body.Attributes[apiGatewayEndpointTypeAttr]
+			if !ok || !isStaticExpr(attr.Expr) {
+				continue
+			}
+
+			var types []string
+			if err := runner.EvaluateExpr(attr.Expr, &types, nil); err != nil {
+				return nil, err
+			}
+			for _, endpointType := range types {
+				if endpointType == apiGatewayPrivateEndpoint {
+					private[resource.Labels[1]] = true
+				}
+			}
+		}
+	}
+
+	return private, nil
+}
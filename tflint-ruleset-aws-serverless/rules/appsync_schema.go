@@ -0,0 +1,480 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// appsyncField is a field of a GraphQL object type
+type appsyncField struct {
+	typeName   string
+	list       bool
+	directives []string
+}
+
+// hasDirective returns true if the field is annotated with the given directive
+func (f appsyncField) hasDirective(name string) bool {
+	for _, directive := range f.directives {
+		if directive == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appsyncSchema is the subset of a GraphQL schema relevant to resolvers
+type appsyncSchema struct {
+	// types maps object type names to their fields
+	types map[string]map[string]appsyncField
+	// operations maps operation types ("query", "mutation", "subscription") to
+	// their root type names
+	operations map[string]string
+}
+
+// rootTypes returns the names of the query, mutation and subscription types
+func (s *appsyncSchema) rootTypes() []string {
+	rootTypes := []string{}
+	for _, operation := range []string{"query", "mutation", "subscription"} {
+		if typeName, ok := s.operations[operation]; ok {
+			rootTypes = append(rootTypes, typeName)
+		}
+	}
+
+	return rootTypes
+}
+
+// listElementTypes returns the names of types returned as list elements by any field
+func (s *appsyncSchema) listElementTypes() map[string]bool {
+	elementTypes := make(map[string]bool)
+	for _, fields := range s.types {
+		for _, field := range fields {
+			if field.list {
+				elementTypes[field.typeName] = true
+			}
+		}
+	}
+
+	return elementTypes
+}
+
+// appsyncSchemaParser parses GraphQL schema definition language (SDL)
+type appsyncSchemaParser struct {
+	tokens []string
+	pos    int
+}
+
+// parseAppsyncSchema parses a GraphQL SDL document.
+//
+// Only object types and schema definitions are interpreted, other definitions
+// such as inputs, enums, unions or directives are skipped.
+func parseAppsyncSchema(sdl string) (*appsyncSchema, error) {
+	tokens, err := tokenizeGraphQL(sdl)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &appsyncSchemaParser{tokens: tokens}
+	schema := &appsyncSchema{
+		types:      make(map[string]map[string]appsyncField),
+		operations: make(map[string]string),
+	}
+
+	for !p.done() {
+		token := p.next()
+
+		switch {
+		case strings.HasPrefix(token, "\""):
+			// Description
+			continue
+		case token == "extend":
+			continue
+		case token == "schema":
+			if err := p.parseSchemaDefinition(schema); err != nil {
+				return nil, err
+			}
+		case token == "type":
+			if err := p.parseObjectType(schema); err != nil {
+				return nil, err
+			}
+		case isGraphQLDefinitionKeyword(token):
+			p.skipDefinition()
+		default:
+			return nil, fmt.Errorf("unexpected token %q", token)
+		}
+	}
+
+	// Default operation types
+	for operation, typeName := range map[string]string{
+		"query":        "Query",
+		"mutation":     "Mutation",
+		"subscription": "Subscription",
+	} {
+		if _, ok := schema.operations[operation]; ok {
+			continue
+		}
+		if _, ok := schema.types[typeName]; ok {
+			schema.operations[operation] = typeName
+		}
+	}
+
+	return schema, nil
+}
+
+func (p *appsyncSchemaParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *appsyncSchemaParser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *appsyncSchemaParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *appsyncSchemaParser) expect(expected string) error {
+	if token := p.next(); token != expected {
+		return fmt.Errorf("expected %q, got %q", expected, token)
+	}
+	return nil
+}
+
+func (p *appsyncSchemaParser) expectName() (string, error) {
+	token := p.next()
+	if !isGraphQLName(token) {
+		return "", fmt.Errorf("expected a name, got %q", token)
+	}
+	return token, nil
+}
+
+// skipGroup skips tokens until the bracket opened by the previous token is closed
+func (p *appsyncSchemaParser) skipGroup() error {
+	depth := 1
+	for depth > 0 {
+		if p.done() {
+			return fmt.Errorf("unexpected end of schema")
+		}
+
+		switch p.next() {
+		case "{", "(", "[":
+			depth++
+		case "}", ")", "]":
+			depth--
+		}
+	}
+
+	return nil
+}
+
+// skipDirectives skips directives and returns their names
+func (p *appsyncSchemaParser) skipDirectives() ([]string, error) {
+	directives := []string{}
+	for p.peek() == "@" {
+		p.next()
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		directives = append(directives, name)
+
+		if p.peek() == "(" {
+			p.next()
+			if err := p.skipGroup(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return directives, nil
+}
+
+// skipDefinition skips a definition until the next top-level keyword
+func (p *appsyncSchemaParser) skipDefinition() {
+	for !p.done() {
+		switch token := p.peek(); {
+		case token == "{" || token == "(":
+			p.next()
+			if err := p.skipGroup(); err != nil {
+				return
+			}
+		case isGraphQLDefinitionKeyword(token) || token == "extend" || strings.HasPrefix(token, "\""):
+			return
+		default:
+			p.next()
+		}
+	}
+}
+
+func (p *appsyncSchemaParser) parseSchemaDefinition(schema *appsyncSchema) error {
+	if _, err := p.skipDirectives(); err != nil {
+		return err
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	for p.peek() != "}" {
+		operation, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		typeName, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		schema.operations[operation] = typeName
+	}
+	p.next()
+
+	return nil
+}
+
+func (p *appsyncSchemaParser) parseObjectType(schema *appsyncSchema) error {
+	name, err := p.expectName()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := schema.types[name]; !ok {
+		schema.types[name] = make(map[string]appsyncField)
+	}
+
+	// Interfaces
+	if p.peek() == "implements" {
+		p.next()
+		for p.peek() == "&" || (isGraphQLName(p.peek()) && !isGraphQLDefinitionKeyword(p.peek())) {
+			p.next()
+		}
+	}
+
+	if _, err := p.skipDirectives(); err != nil {
+		return err
+	}
+
+	// Fields are optional, e.g. for type extensions adding directives
+	if p.peek() != "{" {
+		return nil
+	}
+	p.next()
+
+	for p.peek() != "}" {
+		if p.done() {
+			return fmt.Errorf("unexpected end of schema")
+		}
+
+		if strings.HasPrefix(p.peek(), "\"") {
+			p.next()
+			continue
+		}
+
+		fieldName, err := p.expectName()
+		if err != nil {
+			return err
+		}
+
+		// Arguments
+		if p.peek() == "(" {
+			p.next()
+			if err := p.skipGroup(); err != nil {
+				return err
+			}
+		}
+
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+
+		field, err := p.parseFieldType()
+		if err != nil {
+			return err
+		}
+
+		field.directives, err = p.skipDirectives()
+		if err != nil {
+			return err
+		}
+
+		schema.types[name][fieldName] = field
+	}
+	p.next()
+
+	return nil
+}
+
+func (p *appsyncSchemaParser) parseFieldType() (appsyncField, error) {
+	field := appsyncField{}
+
+	depth := 0
+	for p.peek() == "[" {
+		p.next()
+		field.list = true
+		depth++
+	}
+
+	typeName, err := p.expectName()
+	if err != nil {
+		return field, err
+	}
+	field.typeName = typeName
+
+	if p.peek() == "!" {
+		p.next()
+	}
+	for ; depth > 0; depth-- {
+		if err := p.expect("]"); err != nil {
+			return field, err
+		}
+		if p.peek() == "!" {
+			p.next()
+		}
+	}
+
+	return field, nil
+}
+
+// tokenizeGraphQL splits a GraphQL document into names, punctuators and
+// strings, discarding comments and insignificant characters
+func tokenizeGraphQL(document string) ([]string, error) {
+	tokens := []string{}
+
+	for i := 0; i < len(document); {
+		c := document[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(document) && document[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(document[i:], `"""`):
+			start := i
+			for i += 3; !strings.HasPrefix(document[i:], `"""`); i++ {
+				if i >= len(document) {
+					return nil, fmt.Errorf("unterminated block string")
+				}
+				// Escaped triple quotes do not close the block string
+				if strings.HasPrefix(document[i:], `\"""`) {
+					i += 3
+				}
+			}
+			i += 3
+			tokens = append(tokens, document[start:i])
+		case c == '"':
+			start := i
+			for i++; i < len(document) && document[i] != '"'; i++ {
+				if document[i] == '\\' {
+					i++
+				} else if document[i] == '\n' {
+					return nil, fmt.Errorf("unterminated string")
+				}
+			}
+			if i >= len(document) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			i++
+			tokens = append(tokens, document[start:i])
+		case strings.HasPrefix(document[i:], "..."):
+			tokens = append(tokens, "...")
+			i += 3
+		case strings.IndexByte("!$&()[]{}:=@|", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		case isGraphQLNameChar(c) || c == '-':
+			// Names and numbers
+			start := i
+			for i < len(document) && (isGraphQLNameChar(document[i]) || document[i] == '-' || document[i] == '.' || document[i] == '+') {
+				i++
+			}
+			tokens = append(tokens, document[start:i])
+		default:
+			return nil, fmt.Errorf("unexpected character %q", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func isGraphQLName(token string) bool {
+	if token == "" || (token[0] >= '0' && token[0] <= '9') {
+		return false
+	}
+	for i := 0; i < len(token); i++ {
+		if !isGraphQLNameChar(token[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isGraphQLDefinitionKeyword(token string) bool {
+	switch token {
+	case "schema", "type", "interface", "union", "enum", "scalar", "input", "directive":
+		return true
+	}
+	return false
+}
+
+// appsyncAPISchema is the schema of an "aws_appsync_graphql_api" resource
+type appsyncAPISchema struct {
+	// attr is the "schema" attribute of the API
+	attr *hclext.Attribute
+	// schema is nil if the schema cannot be parsed
+	schema *appsyncSchema
+	// parseErr is set if the schema cannot be parsed. The parser only supports
+	// a subset of SDL, so this does not mean that the schema is invalid.
+	parseErr error
+}
+
+// appsyncAPISchemas returns the schemas of AppSync APIs by resource name.
+//
+// APIs without a static schema are omitted.
+func appsyncAPISchemas(runner tflint.Runner) (map[string]*appsyncAPISchema, error) {
+	schemas := make(map[string]*appsyncAPISchema)
+
+	resources, err := runner.GetResourceContent("aws_appsync_graphql_api", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "schema"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes["schema"]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var sdl string
+		if err := runner.EvaluateExpr(attr.Expr, &sdl, nil); err != nil {
+			return nil, err
+		}
+
+		schema, err := parseAppsyncSchema(sdl)
+		schemas[resource.Labels[1]] = &appsyncAPISchema{
+			attr:     attr,
+			schema:   schema,
+			parseErr: err,
+		}
+	}
+
+	return schemas, nil
+}
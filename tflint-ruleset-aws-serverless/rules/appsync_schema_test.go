@@ -0,0 +1,296 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseAppsyncSchema(t *testing.T) {
+	cases := []struct {
+		Name     string
+		SDL      string
+		Expected *appsyncSchema
+		Error    bool
+	}{
+		{
+			Name: "descriptions",
+			SDL: `
+# Comments are ignored
+"""
+The root query type
+"""
+type Query {
+	"Get a post by ID"
+	getPost(
+		"The ID of the post"
+		id: ID!
+	): Post
+}
+
+"A blog post"
+type Post {
+	"""
+	The title of the post
+	"""
+	title: String
+}
+`,
+			Expected: &appsyncSchema{
+				types: map[string]map[string]appsyncField{
+					"Query": {
+						"getPost": {typeName: "Post", directives: []string{}},
+					},
+					"Post": {
+						"title": {typeName: "String", directives: []string{}},
+					},
+				},
+				operations: map[string]string{"query": "Query"},
+			},
+		},
+		{
+			Name: "escaped triple quotes in block string",
+			SDL: `
+type Query {
+	"""
+	Returns a string like \"""quoted\"""
+	"""
+	quoted: String
+}
+`,
+			Expected: &appsyncSchema{
+				types: map[string]map[string]appsyncField{
+					"Query": {
+						"quoted": {typeName: "String", directives: []string{}},
+					},
+				},
+				operations: map[string]string{"query": "Query"},
+			},
+		},
+		{
+			Name: "extend type",
+			SDL: `
+type Query {
+	getPost(id: ID!): Post
+}
+
+type Post {
+	title: String
+}
+
+extend type Query {
+	listPosts: [Post]
+}
+
+extend type Post @aws_iam
+`,
+			Expected: &appsyncSchema{
+				types: map[string]map[string]appsyncField{
+					"Query": {
+						"getPost":   {typeName: "Post", directives: []string{}},
+						"listPosts": {typeName: "Post", list: true, directives: []string{}},
+					},
+					"Post": {
+						"title": {typeName: "String", directives: []string{}},
+					},
+				},
+				operations: map[string]string{"query": "Query"},
+			},
+		},
+		{
+			Name: "implements",
+			SDL: `
+interface Node {
+	id: ID!
+}
+
+interface Entity {
+	createdAt: AWSDateTime
+}
+
+type Post implements Node & Entity {
+	id: ID!
+	createdAt: AWSDateTime
+}
+
+type Query {
+	node(id: ID!): Node
+}
+`,
+			Expected: &appsyncSchema{
+				types: map[string]map[string]appsyncField{
+					"Post": {
+						"id":        {typeName: "ID", directives: []string{}},
+						"createdAt": {typeName: "AWSDateTime", directives: []string{}},
+					},
+					"Query": {
+						"node": {typeName: "Node", directives: []string{}},
+					},
+				},
+				operations: map[string]string{"query": "Query"},
+			},
+		},
+		{
+			Name: "directives with arguments",
+			SDL: `
+type Mutation @aws_cognito_user_pools(cognito_groups: ["Admin", "Editor"]) {
+	addPost(title: String!): Post
+		@aws_auth(cognito_groups: ["Admin"])
+		@deprecated(reason: "Use createPost")
+}
+
+type Subscription {
+	onAddPost: Post @aws_subscribe(mutations: ["addPost"])
+}
+`,
+			Expected: &appsyncSchema{
+				types: map[string]map[string]appsyncField{
+					"Mutation": {
+						"addPost": {typeName: "Post", directives: []string{"aws_auth", "deprecated"}},
+					},
+					"Subscription": {
+						"onAddPost": {typeName: "Post", directives: []string{"aws_subscribe"}},
+					},
+				},
+				operations: map[string]string{
+					"mutation":     "Mutation",
+					"subscription": "Subscription",
+				},
+			},
+		},
+		{
+			Name: "nested lists and non-null types",
+			SDL: `
+type Query {
+	matrix: [[Int!]!]!
+	required: String!
+	optional: [String]
+}
+`,
+			Expected: &appsyncSchema{
+				types: map[string]map[string]appsyncField{
+					"Query": {
+						"matrix":   {typeName: "Int", list: true, directives: []string{}},
+						"required": {typeName: "String", directives: []string{}},
+						"optional": {typeName: "String", list: true, directives: []string{}},
+					},
+				},
+				operations: map[string]string{"query": "Query"},
+			},
+		},
+		{
+			Name: "schema overrides",
+			SDL: `
+schema {
+	query: RootQuery
+	mutation: RootMutation
+}
+
+type RootQuery {
+	getPost(id: ID!): Post
+}
+
+type RootMutation {
+	addPost(title: String!): Post
+}
+
+type Query {
+	unused: String
+}
+
+type Subscription {
+	onAddPost: Post
+}
+`,
+			Expected: &appsyncSchema{
+				types: map[string]map[string]appsyncField{
+					"RootQuery": {
+						"getPost": {typeName: "Post", directives: []string{}},
+					},
+					"RootMutation": {
+						"addPost": {typeName: "Post", directives: []string{}},
+					},
+					"Query": {
+						"unused": {typeName: "String", directives: []string{}},
+					},
+					"Subscription": {
+						"onAddPost": {typeName: "Post", directives: []string{}},
+					},
+				},
+				operations: map[string]string{
+					"query":        "RootQuery",
+					"mutation":     "RootMutation",
+					"subscription": "Subscription",
+				},
+			},
+		},
+		{
+			Name: "skipped definitions",
+			SDL: `
+scalar Date
+
+enum Status {
+	DRAFT
+	PUBLISHED
+}
+
+input PostInput {
+	title: String = "Untitled"
+	status: Status = DRAFT
+}
+
+union SearchResult = Post | Comment
+
+directive @auth(groups: [String]) on FIELD_DEFINITION
+
+type Query {
+	search(input: PostInput): [SearchResult]
+}
+`,
+			Expected: &appsyncSchema{
+				types: map[string]map[string]appsyncField{
+					"Query": {
+						"search": {typeName: "SearchResult", list: true, directives: []string{}},
+					},
+				},
+				operations: map[string]string{"query": "Query"},
+			},
+		},
+		{
+			Name: "unterminated block string",
+			SDL: `
+type Query {
+	"""
+	Ends with an escaped delimiter \"""
+	getPost: Post
+}
+`,
+			Error: true,
+		},
+		{
+			Name: "missing field type",
+			SDL: `
+type Query {
+	getPost
+}
+`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		schema, err := parseAppsyncSchema(tc.SDL)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("%s: expected an error", tc.Name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		if !reflect.DeepEqual(schema, tc.Expected) {
+			t.Fatalf("%s: expected schema %+v, got %+v", tc.Name, tc.Expected, schema)
+		}
+	}
+}
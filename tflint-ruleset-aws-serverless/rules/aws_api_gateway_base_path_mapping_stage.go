@@ -0,0 +1,187 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type awsAPIGatewayStageMappingSchema struct {
+	mappingResourceType string
+	mappingAttrName     string
+	mappingAPIAttrName  string
+	apiResourceType     string
+	stageResourceTypes  []string
+	stageAttrName       string
+	stageAPIAttrName    string
+}
+
+// AwsAPIGatewayBasePathMappingStage checks if custom domain mappings reference stages defined in the configuration
+type AwsAPIGatewayBasePathMappingStageRule struct {
+	tflint.DefaultRule
+	mappings []awsAPIGatewayStageMappingSchema
+}
+
+// NewAwsAPIGatewayBasePathMappingStageRule returns new rule with default attributes
+func NewAwsAPIGatewayBasePathMappingStageRule() *AwsAPIGatewayBasePathMappingStageRule {
+	return &AwsAPIGatewayBasePathMappingStageRule{
+		mappings: []awsAPIGatewayStageMappingSchema{
+			{
+				mappingResourceType: "aws_api_gateway_base_path_mapping",
+				mappingAttrName:     "stage_name",
+				mappingAPIAttrName:  "api_id",
+				apiResourceType:     "aws_api_gateway_rest_api",
+				stageResourceTypes: []string{
+					"aws_api_gateway_stage",
+					"aws_api_gateway_deployment",
+				},
+				stageAttrName:    "stage_name",
+				stageAPIAttrName: "rest_api_id",
+			},
+			{
+				mappingResourceType: "aws_apigatewayv2_api_mapping",
+				mappingAttrName:     "stage",
+				mappingAPIAttrName:  "api_id",
+				apiResourceType:     "aws_apigatewayv2_api",
+				stageResourceTypes: []string{
+					"aws_apigatewayv2_stage",
+				},
+				stageAttrName:    "name",
+				stageAPIAttrName: "api_id",
+			},
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayBasePathMappingStageRule) Name() string {
+	return "aws_api_gateway_base_path_mapping_stage"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayBasePathMappingStageRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayBasePathMappingStageRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayBasePathMappingStageRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/custom_domain/"
+}
+
+// apiStages returns the static stage names of each API. APIs with a stage
+// whose name cannot be evaluated statically are mapped to nil.
+func (r *AwsAPIGatewayBasePathMappingStageRule) apiStages(runner tflint.Runner, mapping awsAPIGatewayStageMappingSchema) (map[string]map[string]bool, error) {
+	stages := make(map[string]map[string]bool)
+
+	for _, stageResourceType := range mapping.stageResourceTypes {
+		resources, err := runner.GetResourceContent(stageResourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: mapping.stageAttrName},
+				{Name: mapping.stageAPIAttrName},
+			},
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources.Blocks {
+			apiAttr, ok := resource.Body.Attributes[mapping.stageAPIAttrName]
+			if !ok {
+				continue
+			}
+			apiName, ok := resourceReference(apiAttr.Expr, mapping.apiResourceType)
+			if !ok {
+				continue
+			}
+
+			attr, ok := resource.Body.Attributes[mapping.stageAttrName]
+			if !ok {
+				continue
+			}
+			if !isStaticExpr(attr.Expr) {
+				stages[apiName] = nil
+				continue
+			}
+
+			var stageName string
+			if err := runner.EvaluateExpr(attr.Expr, &stageName, nil); err != nil {
+				return nil, err
+			}
+
+			names, ok := stages[apiName]
+			if ok && names == nil {
+				continue
+			}
+			if !ok {
+				names = make(map[string]bool)
+				stages[apiName] = names
+			}
+			names[stageName] = true
+		}
+	}
+
+	return stages, nil
+}
+
+// Check checks if custom domain mappings reference stages defined in the configuration
+func (r *AwsAPIGatewayBasePathMappingStageRule) Check(runner tflint.Runner) error {
+	for _, mapping := range r.mappings {
+		stages, err := r.apiStages(runner, mapping)
+		if err != nil {
+			return err
+		}
+
+		resources, err := runner.GetResourceContent(mapping.mappingResourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: mapping.mappingAttrName},
+				{Name: mapping.mappingAPIAttrName},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			// References to other resources are resolved by Terraform
+			attr, ok := resource.Body.Attributes[mapping.mappingAttrName]
+			if !ok || !isStaticExpr(attr.Expr) {
+				continue
+			}
+
+			// Only check APIs with stages defined in this module
+			apiAttr, ok := resource.Body.Attributes[mapping.mappingAPIAttrName]
+			if !ok {
+				continue
+			}
+			apiName, ok := resourceReference(apiAttr.Expr, mapping.apiResourceType)
+			if !ok {
+				continue
+			}
+			names, ok := stages[apiName]
+			if !ok || names == nil {
+				continue
+			}
+
+			var stageName string
+			if err := runner.EvaluateExpr(attr.Expr, &stageName, nil); err != nil {
+				return err
+			}
+
+			if !names[stageName] {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" does not match any \"%s\" of the API in the configuration.", mapping.mappingAttrName, mapping.stageResourceTypes[0]),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayBasePathMappingStage(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "unknown stage",
+			Content: `
+resource "aws_api_gateway_stage" "this" {
+	rest_api_id = aws_api_gateway_rest_api.this.id
+	stage_name  = "prod"
+}
+
+resource "aws_api_gateway_base_path_mapping" "this" {
+	api_id     = aws_api_gateway_rest_api.this.id
+	stage_name = "production"
+}
+
+resource "aws_apigatewayv2_stage" "this" {
+	api_id = aws_apigatewayv2_api.this.id
+	name   = "prod"
+}
+
+resource "aws_apigatewayv2_api_mapping" "this" {
+	api_id = aws_apigatewayv2_api.this.id
+	stage  = "$default"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayBasePathMappingStageRule(),
+					Message: "\"stage_name\" does not match any \"aws_api_gateway_stage\" of the API in the configuration.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 15},
+						End:      hcl.Pos{Line: 9, Column: 27},
+					},
+				},
+				{
+					Rule:    NewAwsAPIGatewayBasePathMappingStageRule(),
+					Message: "\"stage\" does not match any \"aws_apigatewayv2_stage\" of the API in the configuration.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 19, Column: 11},
+						End:      hcl.Pos{Line: 19, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "stage of another api",
+			Content: `
+resource "aws_api_gateway_stage" "other" {
+	rest_api_id = aws_api_gateway_rest_api.other.id
+	stage_name  = "prod"
+}
+
+resource "aws_api_gateway_stage" "this" {
+	rest_api_id = aws_api_gateway_rest_api.this.id
+	stage_name  = "dev"
+}
+
+resource "aws_api_gateway_base_path_mapping" "this" {
+	api_id     = aws_api_gateway_rest_api.this.id
+	stage_name = "prod"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayBasePathMappingStageRule(),
+					Message: "\"stage_name\" does not match any \"aws_api_gateway_stage\" of the API in the configuration.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 15},
+						End:      hcl.Pos{Line: 14, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_api_gateway_stage" "this" {
+	rest_api_id = aws_api_gateway_rest_api.this.id
+	stage_name  = "prod"
+}
+
+resource "aws_api_gateway_base_path_mapping" "literal" {
+	api_id     = aws_api_gateway_rest_api.this.id
+	stage_name = "prod"
+}
+
+resource "aws_apigatewayv2_api_mapping" "reference" {
+	api_id = aws_apigatewayv2_api.this.id
+	stage  = aws_apigatewayv2_stage.this.id
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "stage from deployment",
+			Content: `
+resource "aws_api_gateway_deployment" "this" {
+	rest_api_id = aws_api_gateway_rest_api.this.id
+	stage_name  = "prod"
+}
+
+resource "aws_api_gateway_base_path_mapping" "this" {
+	api_id     = aws_api_gateway_rest_api.this.id
+	stage_name = "prod"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "stages defined outside the module",
+			Content: `
+resource "aws_api_gateway_base_path_mapping" "external" {
+	api_id     = var.rest_api_id
+	stage_name = "prod"
+}
+
+resource "aws_api_gateway_base_path_mapping" "this" {
+	api_id     = aws_api_gateway_rest_api.this.id
+	stage_name = "prod"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayBasePathMappingStageRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAPIGatewayDomainNameTLS checks if API Gateway custom domains enforce TLS 1.2 or newer
+type AwsAPIGatewayDomainNameTLSRule struct {
+	tflint.DefaultRule
+	restResourceType string
+	httpResourceType string
+	httpBlockName    string
+	attributeName    string
+	weakPolicies     []string
+}
+
+// NewAwsAPIGatewayDomainNameTLSRule returns new rule with default attributes
+func NewAwsAPIGatewayDomainNameTLSRule() *AwsAPIGatewayDomainNameTLSRule {
+	return &AwsAPIGatewayDomainNameTLSRule{
+		restResourceType: "aws_api_gateway_domain_name",
+		httpResourceType: "aws_apigatewayv2_domain_name",
+		httpBlockName:    "domain_name_configuration",
+		attributeName:    "security_policy",
+		weakPolicies: []string{
+			"TLS_1_0",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayDomainNameTLSRule) Name() string {
+	return "aws_api_gateway_domain_name_tls"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayDomainNameTLSRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayDomainNameTLSRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayDomainNameTLSRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/custom_domain/"
+}
+
+// checkSecurityPolicy checks if the security policy is present and not a weak policy
+func (r *AwsAPIGatewayDomainNameTLSRule) checkSecurityPolicy(runner tflint.Runner, block *hclext.Block) error {
+	attr, ok := block.Body.Attributes[r.attributeName]
+	if !ok {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.attributeName),
+			block.DefRange,
+		)
+		return nil
+	}
+
+	if !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var policy string
+	if err := runner.EvaluateExpr(attr.Expr, &policy, nil); err != nil {
+		return err
+	}
+
+	for _, weakPolicy := range r.weakPolicies {
+		if policy == weakPolicy {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be set to TLS_1_2 or newer.", r.attributeName),
+				attr.Expr.Range(),
+			)
+			break
+		}
+	}
+
+	return nil
+}
+
+// Check checks if API Gateway custom domains enforce TLS 1.2 or newer
+func (r *AwsAPIGatewayDomainNameTLSRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.restResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := r.checkSecurityPolicy(runner, resource); err != nil {
+			return err
+		}
+	}
+
+	resources, err = runner.GetResourceContent(r.httpResourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.httpBlockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.attributeName},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, block := range resource.Body.Blocks {
+			if err := r.checkSecurityPolicy(runner, block); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
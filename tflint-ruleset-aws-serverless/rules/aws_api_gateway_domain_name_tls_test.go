@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayDomainNameTLS(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing security_policy",
+			Content: `
+resource "aws_api_gateway_domain_name" "this" {
+	domain_name = "api.example.com"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayDomainNameTLSRule(),
+					Message: "\"security_policy\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "weak security_policy",
+			Content: `
+resource "aws_api_gateway_domain_name" "this" {
+	domain_name = "api.example.com"
+	security_policy = "TLS_1_0"
+}
+
+resource "aws_apigatewayv2_domain_name" "this" {
+	domain_name = "http.example.com"
+	domain_name_configuration {
+		security_policy = "TLS_1_0"
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayDomainNameTLSRule(),
+					Message: "\"security_policy\" should be set to TLS_1_2 or newer.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 20},
+						End:      hcl.Pos{Line: 4, Column: 29},
+					},
+				},
+				{
+					Rule:    NewAwsAPIGatewayDomainNameTLSRule(),
+					Message: "\"security_policy\" should be set to TLS_1_2 or newer.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 21},
+						End:      hcl.Pos{Line: 10, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_api_gateway_domain_name" "this" {
+	domain_name = "api.example.com"
+	security_policy = "TLS_1_2"
+}
+
+resource "aws_apigatewayv2_domain_name" "this" {
+	domain_name = "http.example.com"
+	domain_name_configuration {
+		security_policy = "TLS_1_2"
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayDomainNameTLSRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,182 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type awsLambdaFunctionTimeout struct {
+	timeout    int
+	blockRange hcl.Range
+}
+
+type awsAPIGatewayIntegrationSchema struct {
+	resourceType   string
+	typeAttrName   string
+	uriAttrName    string
+	defaultTimeout int
+}
+
+// AwsAPIGatewayIntegrationLambdaTimeout checks if Lambda functions behind an API Gateway proxy integration time out before the integration
+type AwsAPIGatewayIntegrationLambdaTimeoutRule struct {
+	tflint.DefaultRule
+	functionResourceType   string
+	timeoutAttrName        string
+	integrationTimeout     string
+	proxyType              string
+	defaultFunctionTimeout int
+	integrations           []awsAPIGatewayIntegrationSchema
+}
+
+// NewAwsAPIGatewayIntegrationLambdaTimeoutRule returns new rule with default attributes
+func NewAwsAPIGatewayIntegrationLambdaTimeoutRule() *AwsAPIGatewayIntegrationLambdaTimeoutRule {
+	return &AwsAPIGatewayIntegrationLambdaTimeoutRule{
+		functionResourceType:   "aws_lambda_function",
+		timeoutAttrName:        "timeout",
+		integrationTimeout:     "timeout_milliseconds",
+		proxyType:              "AWS_PROXY",
+		defaultFunctionTimeout: 3,
+		integrations: []awsAPIGatewayIntegrationSchema{
+			{
+				resourceType:   "aws_api_gateway_integration",
+				typeAttrName:   "type",
+				uriAttrName:    "uri",
+				defaultTimeout: 29000,
+			},
+			{
+				resourceType:   "aws_apigatewayv2_integration",
+				typeAttrName:   "integration_type",
+				uriAttrName:    "integration_uri",
+				defaultTimeout: 30000,
+			},
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayIntegrationLambdaTimeoutRule) Name() string {
+	return "aws_api_gateway_integration_lambda_timeout"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayIntegrationLambdaTimeoutRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayIntegrationLambdaTimeoutRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayIntegrationLambdaTimeoutRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/lambda_timeout/"
+}
+
+// Check checks if Lambda functions behind an API Gateway proxy integration time out before the integration
+func (r *AwsAPIGatewayIntegrationLambdaTimeoutRule) Check(runner tflint.Runner) error {
+	// Gather all Lambda function timeouts
+	functions := make(map[string]awsLambdaFunctionTimeout)
+	resources, err := runner.GetResourceContent(r.functionResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.timeoutAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		function := awsLambdaFunctionTimeout{
+			timeout:    r.defaultFunctionTimeout,
+			blockRange: resource.DefRange,
+		}
+
+		attribute, ok := resource.Body.Attributes[r.timeoutAttrName]
+		if ok {
+			// Timeout cannot be compared if it depends on other resources
+			if !isStaticExpr(attribute.Expr) {
+				continue
+			}
+
+			if err := runner.EvaluateExpr(attribute.Expr, &function.timeout, nil); err != nil {
+				return err
+			}
+			function.blockRange = attribute.Expr.Range()
+		}
+
+		functions[resource.Labels[1]] = function
+	}
+
+	for _, integration := range r.integrations {
+		resources, err := runner.GetResourceContent(integration.resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: integration.typeAttrName},
+				{Name: integration.uriAttrName},
+				{Name: r.integrationTimeout},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			typeAttr, ok := resource.Body.Attributes[integration.typeAttrName]
+			if !ok || !isStaticExpr(typeAttr.Expr) {
+				continue
+			}
+
+			var integrationType string
+			if err := runner.EvaluateExpr(typeAttr.Expr, &integrationType, nil); err != nil {
+				return err
+			}
+			if integrationType != r.proxyType {
+				continue
+			}
+
+			// Only functions defined in this configuration can be compared
+			uriAttr, ok := resource.Body.Attributes[integration.uriAttrName]
+			if !ok {
+				continue
+			}
+			functionName, ok := resourceReference(uriAttr.Expr, r.functionResourceType)
+			if !ok {
+				continue
+			}
+			function, ok := functions[functionName]
+			if !ok {
+				continue
+			}
+
+			timeout := integration.defaultTimeout
+			if timeoutAttr, ok := resource.Body.Attributes[r.integrationTimeout]; ok {
+				if !isStaticExpr(timeoutAttr.Expr) {
+					continue
+				}
+				if err := runner.EvaluateExpr(timeoutAttr.Expr, &timeout, nil); err != nil {
+					return err
+				}
+			}
+
+			if function.timeout*1000 > timeout {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf(
+						"\"%s\" of %ds exceeds the %dms timeout of %s.%s.",
+						r.timeoutAttrName,
+						function.timeout,
+						timeout,
+						integration.resourceType,
+						resource.Labels[1],
+					),
+					function.blockRange,
+				)
+			}
+		}
+	}
+
+	return nil
+}
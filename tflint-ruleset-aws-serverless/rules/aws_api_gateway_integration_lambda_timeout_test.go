@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayIntegrationLambdaTimeout(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "rest timeout above default integration timeout",
+			Content: `
+resource "aws_lambda_function" "this" {
+	function_name = "my-function"
+	timeout = 60
+}
+
+resource "aws_api_gateway_integration" "this" {
+	type = "AWS_PROXY"
+	uri = aws_lambda_function.this.invoke_arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayIntegrationLambdaTimeoutRule(),
+					Message: "\"timeout\" of 60s exceeds the 29000ms timeout of aws_api_gateway_integration.this.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 14},
+					},
+				},
+			},
+		},
+		{
+			Name: "http timeout above custom integration timeout",
+			Content: `
+resource "aws_lambda_function" "this" {
+	function_name = "my-function"
+	timeout = 10
+}
+
+resource "aws_apigatewayv2_integration" "this" {
+	integration_type = "AWS_PROXY"
+	integration_uri = aws_lambda_function.this.invoke_arn
+	timeout_milliseconds = 5000
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayIntegrationLambdaTimeoutRule(),
+					Message: "\"timeout\" of 10s exceeds the 5000ms timeout of aws_apigatewayv2_integration.this.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 14},
+					},
+				},
+			},
+		},
+		{
+			Name: "http timeout below default integration timeout",
+			Content: `
+resource "aws_lambda_function" "this" {
+	function_name = "my-function"
+	timeout = 30
+}
+
+resource "aws_apigatewayv2_integration" "this" {
+	integration_type = "AWS_PROXY"
+	integration_uri = aws_lambda_function.this.invoke_arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "non-proxy integration",
+			Content: `
+resource "aws_lambda_function" "this" {
+	function_name = "my-function"
+	timeout = 60
+}
+
+resource "aws_api_gateway_integration" "this" {
+	type = "AWS"
+	uri = aws_lambda_function.this.invoke_arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayIntegrationLambdaTimeoutRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,182 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAPIGatewayIntegrationResponseCors checks if REST API OPTIONS mock integrations allow any origin
+type AwsAPIGatewayIntegrationResponseCorsRule struct {
+	tflint.DefaultRule
+	resourceType        string
+	methodType          string
+	integrationType     string
+	httpMethodAttrName  string
+	typeAttrName        string
+	parametersAttrName  string
+	optionsMethod       string
+	mockType            string
+	allowOriginParam    string
+	wildcardOriginValue string
+}
+
+// NewAwsAPIGatewayIntegrationResponseCorsRule returns new rule with default attributes
+func NewAwsAPIGatewayIntegrationResponseCorsRule() *AwsAPIGatewayIntegrationResponseCorsRule {
+	return &AwsAPIGatewayIntegrationResponseCorsRule{
+		resourceType:        "aws_api_gateway_integration_response",
+		methodType:          "aws_api_gateway_method",
+		integrationType:     "aws_api_gateway_integration",
+		httpMethodAttrName:  "http_method",
+		typeAttrName:        "type",
+		parametersAttrName:  "response_parameters",
+		optionsMethod:       "OPTIONS",
+		mockType:            "MOCK",
+		allowOriginParam:    "method.response.header.Access-Control-Allow-Origin",
+		wildcardOriginValue: "'*'",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayIntegrationResponseCorsRule) Name() string {
+	return "aws_api_gateway_integration_response_cors"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayIntegrationResponseCorsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayIntegrationResponseCorsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayIntegrationResponseCorsRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/cors/"
+}
+
+// evaluateString returns the value of a static string attribute
+func (r *AwsAPIGatewayIntegrationResponseCorsRule) evaluateString(runner tflint.Runner, body *hclext.BodyContent, attrName string) (string, bool, error) {
+	attr, ok := body.Attributes[attrName]
+	if !ok || !isStaticExpr(attr.Expr) {
+		return "", false, nil
+	}
+
+	var value string
+	if err := runner.EvaluateExpr(attr.Expr, &value, nil); err != nil {
+		return "", false, err
+	}
+
+	return value, true, nil
+}
+
+// Check checks if REST API OPTIONS mock integrations allow any origin
+func (r *AwsAPIGatewayIntegrationResponseCorsRule) Check(runner tflint.Runner) error {
+	// Gather OPTIONS methods
+	optionsMethods := make(map[string]bool)
+	resources, err := runner.GetResourceContent(r.methodType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.httpMethodAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		httpMethod, ok, err := r.evaluateString(runner, resource.Body, r.httpMethodAttrName)
+		if err != nil {
+			return err
+		}
+		optionsMethods[resource.Labels[1]] = ok && httpMethod == r.optionsMethod
+	}
+
+	// Gather methods with a non-mock integration
+	nonMockMethods := make(map[string]bool)
+	resources, err = runner.GetResourceContent(r.integrationType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.httpMethodAttrName},
+			{Name: r.typeAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.httpMethodAttrName]
+		if !ok {
+			continue
+		}
+		methodName, ok := resourceReference(attr.Expr, r.methodType)
+		if !ok {
+			continue
+		}
+
+		integrationType, ok, err := r.evaluateString(runner, resource.Body, r.typeAttrName)
+		if err != nil {
+			return err
+		}
+		if ok && integrationType != r.mockType {
+			nonMockMethods[methodName] = true
+		}
+	}
+
+	resources, err = runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.httpMethodAttrName},
+			{Name: r.parametersAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		// Only integration responses for OPTIONS mock integrations are relevant
+		httpMethodAttr, ok := resource.Body.Attributes[r.httpMethodAttrName]
+		if !ok {
+			continue
+		}
+		if methodName, ok := resourceReference(httpMethodAttr.Expr, r.methodType); ok {
+			if !optionsMethods[methodName] || nonMockMethods[methodName] {
+				continue
+			}
+		} else {
+			httpMethod, ok, err := r.evaluateString(runner, resource.Body, r.httpMethodAttrName)
+			if err != nil {
+				return err
+			}
+			if !ok || httpMethod != r.optionsMethod {
+				continue
+			}
+		}
+
+		parametersAttr, ok := resource.Body.Attributes[r.parametersAttrName]
+		if !ok || !isStaticExpr(parametersAttr.Expr) {
+			continue
+		}
+
+		var parameters map[string]string
+		if err := runner.EvaluateExpr(parametersAttr.Expr, &parameters, nil); err != nil {
+			return err
+		}
+
+		for name, value := range parameters {
+			// Header names are case-insensitive
+			if strings.EqualFold(name, r.allowOriginParam) && value == r.wildcardOriginValue {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" allows any origin with %s.", r.parametersAttrName, r.wildcardOriginValue),
+					parametersAttr.Expr.Range(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayIntegrationResponseCors(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "wildcard origin on mock options integration",
+			Content: `
+resource "aws_api_gateway_method" "options" {
+	http_method = "OPTIONS"
+}
+
+resource "aws_api_gateway_integration" "options" {
+	http_method = aws_api_gateway_method.options.http_method
+	type = "MOCK"
+}
+
+resource "aws_api_gateway_integration_response" "options" {
+	http_method = aws_api_gateway_method.options.http_method
+	response_parameters = {
+		"method.response.header.Access-Control-Allow-Origin" = "'*'"
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayIntegrationResponseCorsRule(),
+					Message: "\"response_parameters\" allows any origin with '*'.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 13, Column: 24},
+						End:      hcl.Pos{Line: 15, Column: 3},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard origin on literal options method",
+			Content: `
+resource "aws_api_gateway_integration_response" "options" {
+	http_method = "OPTIONS"
+	response_parameters = {
+		"method.response.header.Access-Control-Allow-Origin" = "'*'"
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayIntegrationResponseCorsRule(),
+					Message: "\"response_parameters\" allows any origin with '*'.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 6, Column: 3},
+					},
+				},
+			},
+		},
+		{
+			Name: "explicit origin",
+			Content: `
+resource "aws_api_gateway_integration_response" "options" {
+	http_method = "OPTIONS"
+	response_parameters = {
+		"method.response.header.Access-Control-Allow-Origin" = "'https://example.com'"
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "non-options method",
+			Content: `
+resource "aws_api_gateway_method" "get" {
+	http_method = "GET"
+}
+
+resource "aws_api_gateway_integration_response" "get" {
+	http_method = aws_api_gateway_method.get.http_method
+	response_parameters = {
+		"method.response.header.Access-Control-Allow-Origin" = "'*'"
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayIntegrationResponseCorsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
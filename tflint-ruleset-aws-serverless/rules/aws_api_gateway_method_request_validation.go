@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAPIGatewayMethodRequestValidation checks if REST API methods accepting a request body have a request validator
+type AwsAPIGatewayMethodRequestValidationRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	validatorType      string
+	httpMethodAttrName string
+	modelsAttrName     string
+	validatorAttrName  string
+	validateAttrNames  []string
+	bodyMethods        []string
+}
+
+// NewAwsAPIGatewayMethodRequestValidationRule returns new rule with default attributes
+func NewAwsAPIGatewayMethodRequestValidationRule() *AwsAPIGatewayMethodRequestValidationRule {
+	return &AwsAPIGatewayMethodRequestValidationRule{
+		resourceType:       "aws_api_gateway_method",
+		validatorType:      "aws_api_gateway_request_validator",
+		httpMethodAttrName: "http_method",
+		modelsAttrName:     "request_models",
+		validatorAttrName:  "request_validator_id",
+		validateAttrNames: []string{
+			"validate_request_body",
+			"validate_request_parameters",
+		},
+		bodyMethods: []string{
+			"POST",
+			"PUT",
+			"PATCH",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayMethodRequestValidationRule) Name() string {
+	return "aws_api_gateway_method_request_validation"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayMethodRequestValidationRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayMethodRequestValidationRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayMethodRequestValidationRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/request_validation/"
+}
+
+// Check checks if REST API methods accepting a request body have a request validator
+func (r *AwsAPIGatewayMethodRequestValidationRule) Check(runner tflint.Runner) error {
+	// Gather request validators that validate something
+	validators := make(map[string]bool)
+	resources, err := runner.GetResourceContent(r.validatorType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.validateAttrNames[0]},
+			{Name: r.validateAttrNames[1]},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		validates := false
+		for _, attrName := range r.validateAttrNames {
+			attribute, ok := resource.Body.Attributes[attrName]
+			if !ok {
+				continue
+			}
+
+			// Assume validation is enabled when it cannot be determined
+			if !isStaticExpr(attribute.Expr) {
+				validates = true
+				break
+			}
+
+			var value bool
+			if err := runner.EvaluateExpr(attribute.Expr, &value, nil); err != nil {
+				return err
+			}
+			if value {
+				validates = true
+				break
+			}
+		}
+
+		validators[resource.Labels[1]] = validates
+	}
+
+	resources, err = runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.httpMethodAttrName},
+			{Name: r.modelsAttrName},
+			{Name: r.validatorAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		// Only methods with request models and a body are relevant
+		if _, ok := resource.Body.Attributes[r.modelsAttrName]; !ok {
+			continue
+		}
+
+		httpMethodAttr, ok := resource.Body.Attributes[r.httpMethodAttrName]
+		if !ok || !isStaticExpr(httpMethodAttr.Expr) {
+			continue
+		}
+
+		var httpMethod string
+		if err := runner.EvaluateExpr(httpMethodAttr.Expr, &httpMethod, nil); err != nil {
+			return err
+		}
+
+		acceptsBody := false
+		for _, bodyMethod := range r.bodyMethods {
+			if httpMethod == bodyMethod {
+				acceptsBody = true
+				break
+			}
+		}
+		if !acceptsBody {
+			continue
+		}
+
+		validatorAttr, ok := resource.Body.Attributes[r.validatorAttrName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.validatorAttrName),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		validatorName, ok := resourceReference(validatorAttr.Expr, r.validatorType)
+		if !ok {
+			continue
+		}
+
+		if validates, ok := validators[validatorName]; ok && !validates {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf(
+					"%s.%s does not enable \"%s\" or \"%s\".",
+					r.validatorType,
+					validatorName,
+					r.validateAttrNames[0],
+					r.validateAttrNames[1],
+				),
+				validatorAttr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
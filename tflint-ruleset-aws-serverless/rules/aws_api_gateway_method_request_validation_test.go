@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayMethodRequestValidation(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing request_validator_id",
+			Content: `
+resource "aws_api_gateway_method" "this" {
+	http_method = "POST"
+	request_models = {
+		"application/json" = "MyModel"
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodRequestValidationRule(),
+					Message: "\"request_validator_id\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "validator without validation",
+			Content: `
+resource "aws_api_gateway_request_validator" "this" {
+	name = "noop"
+	validate_request_body = false
+}
+
+resource "aws_api_gateway_method" "this" {
+	http_method = "PUT"
+	request_models = {
+		"application/json" = "MyModel"
+	}
+	request_validator_id = aws_api_gateway_request_validator.this.id
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodRequestValidationRule(),
+					Message: "aws_api_gateway_request_validator.this does not enable \"validate_request_body\" or \"validate_request_parameters\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 25},
+						End:      hcl.Pos{Line: 12, Column: 66},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_api_gateway_request_validator" "this" {
+	name = "body"
+	validate_request_body = true
+}
+
+resource "aws_api_gateway_method" "this" {
+	http_method = "POST"
+	request_models = {
+		"application/json" = "MyModel"
+	}
+	request_validator_id = aws_api_gateway_request_validator.this.id
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "method without body",
+			Content: `
+resource "aws_api_gateway_method" "this" {
+	http_method = "GET"
+	request_models = {
+		"application/json" = "MyModel"
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayMethodRequestValidationRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAPIGatewayMethodSettingsCacheEncryption checks if REST API caches are encrypted
+type AwsAPIGatewayMethodSettingsCacheEncryptionRule struct {
+	tflint.DefaultRule
+	attributeName string
+}
+
+// NewAwsAPIGatewayMethodSettingsCacheEncryptionRule returns new rule with default attributes
+func NewAwsAPIGatewayMethodSettingsCacheEncryptionRule() *AwsAPIGatewayMethodSettingsCacheEncryptionRule {
+	return &AwsAPIGatewayMethodSettingsCacheEncryptionRule{
+		attributeName: "cache_data_encrypted",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayMethodSettingsCacheEncryptionRule) Name() string {
+	return "aws_api_gateway_method_settings_cache_encryption"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayMethodSettingsCacheEncryptionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayMethodSettingsCacheEncryptionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayMethodSettingsCacheEncryptionRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/caching/"
+}
+
+// Check checks if REST API caches are encrypted
+func (r *AwsAPIGatewayMethodSettingsCacheEncryptionRule) Check(runner tflint.Runner) error {
+	stages, err := apiGatewayCacheStages(runner)
+	if err != nil {
+		return err
+	}
+
+	settingsBlocks, referenced, err := apiGatewayCachedMethodSettings(runner, []string{r.attributeName}, stages)
+	if err != nil {
+		return err
+	}
+
+	// Stages with a cache cluster need method settings to encrypt it
+	for stageName, attr := range stages {
+		if !referenced[stageName] {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is true but no \"%s\" sets \"%s\".", cacheClusterAttrName, apiGatewayMethodSettingsType, r.attributeName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	for _, settings := range settingsBlocks {
+		attr, ok := settings.Body.Attributes[r.attributeName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.attributeName),
+				settings.DefRange,
+			)
+			continue
+		}
+
+		if !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var encrypted bool
+		if err := runner.EvaluateExpr(attr.Expr, &encrypted, nil); err != nil {
+			return err
+		}
+
+		if !encrypted {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be set to true.", r.attributeName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
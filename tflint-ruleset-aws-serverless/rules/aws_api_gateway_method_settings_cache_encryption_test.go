@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayMethodSettingsCacheEncryption(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing cache_data_encrypted",
+			Content: `
+resource "aws_api_gateway_method_settings" "this" {
+	method_path = "*/*"
+	settings {
+		caching_enabled = true
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsCacheEncryptionRule(),
+					Message: "\"cache_data_encrypted\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 2},
+						End:      hcl.Pos{Line: 4, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			Name: "cache_data_encrypted is false on cached stage",
+			Content: `
+resource "aws_api_gateway_stage" "this" {
+	stage_name = "prod"
+	cache_cluster_enabled = true
+}
+
+resource "aws_api_gateway_method_settings" "this" {
+	stage_name = aws_api_gateway_stage.this.stage_name
+	method_path = "*/*"
+	settings {
+		cache_data_encrypted = false
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsCacheEncryptionRule(),
+					Message: "\"cache_data_encrypted\" should be set to true.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 26},
+						End:      hcl.Pos{Line: 11, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "cached stage without method settings",
+			Content: `
+resource "aws_api_gateway_stage" "this" {
+	stage_name = "prod"
+	cache_cluster_enabled = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsCacheEncryptionRule(),
+					Message: "\"cache_cluster_enabled\" is true but no \"aws_api_gateway_method_settings\" sets \"cache_data_encrypted\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 26},
+						End:      hcl.Pos{Line: 4, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_api_gateway_stage" "this" {
+	stage_name = "prod"
+	cache_cluster_enabled = true
+}
+
+resource "aws_api_gateway_method_settings" "this" {
+	stage_name = aws_api_gateway_stage.this.stage_name
+	method_path = "*/*"
+	settings {
+		caching_enabled = true
+		cache_data_encrypted = true
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "caching disabled",
+			Content: `
+resource "aws_api_gateway_method_settings" "this" {
+	method_path = "*/*"
+	settings {
+		throttling_burst_limit = 1000
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayMethodSettingsCacheEncryptionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
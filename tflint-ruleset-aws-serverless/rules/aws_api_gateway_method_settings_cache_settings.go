@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// awsCacheSettingsConfig is the rule configuration for the cache TTL range
+type awsCacheSettingsConfig struct {
+	MinTTL int `hclext:"min_ttl,optional"`
+	MaxTTL int `hclext:"max_ttl,optional"`
+}
+
+// AwsAPIGatewayMethodSettingsCacheSettings checks if REST API caches have a bounded TTL and require authorization for cache control
+type AwsAPIGatewayMethodSettingsCacheSettingsRule struct {
+	tflint.DefaultRule
+	ttlAttrName           string
+	authorizationAttrName string
+}
+
+// NewAwsAPIGatewayMethodSettingsCacheSettingsRule returns new rule with default attributes
+func NewAwsAPIGatewayMethodSettingsCacheSettingsRule() *AwsAPIGatewayMethodSettingsCacheSettingsRule {
+	return &AwsAPIGatewayMethodSettingsCacheSettingsRule{
+		ttlAttrName:           "cache_ttl_in_seconds",
+		authorizationAttrName: "require_authorization_for_cache_control",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayMethodSettingsCacheSettingsRule) Name() string {
+	return "aws_api_gateway_method_settings_cache_settings"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayMethodSettingsCacheSettingsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayMethodSettingsCacheSettingsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayMethodSettingsCacheSettingsRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/caching/"
+}
+
+// Check checks if REST API caches have a bounded TTL and require authorization for cache control
+func (r *AwsAPIGatewayMethodSettingsCacheSettingsRule) Check(runner tflint.Runner) error {
+	config := &awsCacheSettingsConfig{
+		MinTTL: 1,
+		MaxTTL: 3600,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	stages, err := apiGatewayCacheStages(runner)
+	if err != nil {
+		return err
+	}
+
+	settingsBlocks, _, err := apiGatewayCachedMethodSettings(runner, []string{r.ttlAttrName, r.authorizationAttrName}, stages)
+	if err != nil {
+		return err
+	}
+
+	for _, settings := range settingsBlocks {
+		// Check TTL range
+		if attr, ok := settings.Body.Attributes[r.ttlAttrName]; ok && isStaticExpr(attr.Expr) {
+			var ttl int
+			if err := runner.EvaluateExpr(attr.Expr, &ttl, nil); err != nil {
+				return err
+			}
+
+			if ttl < config.MinTTL || ttl > config.MaxTTL {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should be between %d and %d.", r.ttlAttrName, config.MinTTL, config.MaxTTL),
+					attr.Expr.Range(),
+				)
+			}
+		}
+
+		// Check authorization for cache invalidation, which is required by default
+		attr, ok := settings.Body.Attributes[r.authorizationAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var required bool
+		if err := runner.EvaluateExpr(attr.Expr, &required, nil); err != nil {
+			return err
+		}
+
+		if !required {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be set to true.", r.authorizationAttrName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
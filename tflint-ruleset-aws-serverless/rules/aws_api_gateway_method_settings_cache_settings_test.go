@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayMethodSettingsCacheSettings(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "ttl out of range",
+			Content: `
+resource "aws_api_gateway_method_settings" "this" {
+	method_path = "*/*"
+	settings {
+		caching_enabled = true
+		cache_ttl_in_seconds = 0
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsCacheSettingsRule(),
+					Message: "\"cache_ttl_in_seconds\" should be between 1 and 3600.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 26},
+						End:      hcl.Pos{Line: 6, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "ttl out of configured range",
+			Content: `
+resource "aws_api_gateway_method_settings" "this" {
+	method_path = "*/*"
+	settings {
+		caching_enabled = true
+		cache_ttl_in_seconds = 300
+	}
+}
+`,
+			Config: `
+rule "aws_api_gateway_method_settings_cache_settings" {
+	enabled = true
+	max_ttl = 60
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsCacheSettingsRule(),
+					Message: "\"cache_ttl_in_seconds\" should be between 1 and 60.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 26},
+						End:      hcl.Pos{Line: 6, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "authorization not required",
+			Content: `
+resource "aws_api_gateway_method_settings" "this" {
+	method_path = "*/*"
+	settings {
+		caching_enabled = true
+		require_authorization_for_cache_control = false
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsCacheSettingsRule(),
+					Message: "\"require_authorization_for_cache_control\" should be set to true.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 45},
+						End:      hcl.Pos{Line: 6, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_api_gateway_method_settings" "this" {
+	method_path = "*/*"
+	settings {
+		caching_enabled = true
+		cache_ttl_in_seconds = 300
+		require_authorization_for_cache_control = true
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayMethodSettingsCacheSettingsRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
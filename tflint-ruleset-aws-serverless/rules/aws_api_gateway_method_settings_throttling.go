@@ -7,7 +7,7 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
-// AwsAPIGatewayMethodSettingsThrottlingRule checks whether there is a default "aws_api_gateway_method_settings" resource with throttling values
+// AwsAPIGatewayMethodSettingsThrottlingRule checks whether there is a default "aws_api_gateway_method_settings" resource with valid throttling values
 type AwsAPIGatewayMethodSettingsThrottlingRule struct {
 	tflint.DefaultRule
 }
@@ -36,8 +36,14 @@ func (r *AwsAPIGatewayMethodSettingsThrottlingRule) Link() string {
 	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/default_throttling/"
 }
 
-// Check checks whether default "aws_api_gateway_method_settings" have throttling values
+// Check checks whether default "aws_api_gateway_method_settings" have throttling values,
+// and that throttling values for all method paths are within bounds
 func (r *AwsAPIGatewayMethodSettingsThrottlingRule) Check(runner tflint.Runner) error {
+	config := newAwsThrottlingConfig()
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return fmt.Errorf("failed to decode rule config: %w", err)
+	}
+
 	resources, err := runner.GetResourceContent("aws_api_gateway_method_settings", &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: "method_path"},
@@ -47,8 +53,8 @@ func (r *AwsAPIGatewayMethodSettingsThrottlingRule) Check(runner tflint.Runner)
 				Type: "settings",
 				Body: &hclext.BodySchema{
 					Attributes: []hclext.AttributeSchema{
-						{Name: "throttling_burst_limit"},
-						{Name: "throttling_rate_limit"},
+						{Name: throttlingBurstAttrName},
+						{Name: throttlingRateAttrName},
 					},
 				},
 			},
@@ -59,7 +65,6 @@ func (r *AwsAPIGatewayMethodSettingsThrottlingRule) Check(runner tflint.Runner)
 	}
 
 	for _, resource := range resources.Blocks {
-		// Only looking at the default method settings
 		methodPath, exists := resource.Body.Attributes["method_path"]
 		if !exists {
 			continue
@@ -70,24 +75,25 @@ func (r *AwsAPIGatewayMethodSettingsThrottlingRule) Check(runner tflint.Runner)
 			return fmt.Errorf("failed to evaluate method_path: %w", err)
 		}
 
-		if path != "*/*" {
-			continue
-		}
+		// Throttling values are only required for the default method settings
+		isDefault := path == "*/*"
 
 		// Load 'settings' block
 		settingsBlocks := resource.Body.Blocks
 		if len(settingsBlocks) == 0 {
-			runner.EmitIssue(
-				r,
-				"\"settings\" block is required for default method settings",
-				resource.DefRange,
-			)
+			if isDefault {
+				runner.EmitIssue(
+					r,
+					"\"settings\" block is required for default method settings",
+					resource.DefRange,
+				)
+			}
 			continue
 		}
 
 		settings := settingsBlocks[0].Body
 		// Check throttling limits
-		if _, exists := settings.Attributes["throttling_burst_limit"]; !exists {
+		if _, exists := settings.Attributes[throttlingBurstAttrName]; !exists && isDefault {
 			runner.EmitIssue(
 				r,
 				"\"throttling_burst_limit\" is required for default method settings",
@@ -95,13 +101,17 @@ func (r *AwsAPIGatewayMethodSettingsThrottlingRule) Check(runner tflint.Runner)
 			)
 		}
 
-		if _, exists := settings.Attributes["throttling_rate_limit"]; !exists {
+		if _, exists := settings.Attributes[throttlingRateAttrName]; !exists && isDefault {
 			runner.EmitIssue(
 				r,
 				"\"throttling_rate_limit\" is required for default method settings",
 				settingsBlocks[0].DefRange,
 			)
 		}
+
+		if err := checkThrottlingLimits(runner, r, settings, config); err != nil {
+			return fmt.Errorf("failed to evaluate throttling limits: %w", err)
+		}
 	}
 
 	return nil
@@ -11,6 +11,7 @@ func Test_AwsAPIGatewayMethodSettingsThrottlingRule(t *testing.T) {
 	cases := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -80,6 +81,107 @@ resource "aws_api_gateway_method_settings" "missingrate" {
 				},
 			},
 		},
+		{
+			Name: "burst lower than rate is invalid",
+			Content: `
+resource "aws_api_gateway_method_settings" "lowburst" {
+	method_path = "*/*"
+	settings {
+		throttling_burst_limit = 10
+		throttling_rate_limit = 100
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsThrottlingRule(),
+					Message: "\"throttling_burst_limit\" should be greater than or equal to \"throttling_rate_limit\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 28},
+						End:      hcl.Pos{Line: 5, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "unlimited and negative values are invalid",
+			Content: `
+resource "aws_api_gateway_method_settings" "unlimited" {
+	method_path = "*/*"
+	settings {
+		throttling_burst_limit = -1
+		throttling_rate_limit = -5
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsThrottlingRule(),
+					Message: "\"throttling_burst_limit\" should not be -1 (unlimited).",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 28},
+						End:      hcl.Pos{Line: 5, Column: 30},
+					},
+				},
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsThrottlingRule(),
+					Message: "\"throttling_rate_limit\" should not be negative.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 27},
+						End:      hcl.Pos{Line: 6, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "per-method values above the account limit are invalid",
+			Content: `
+resource "aws_api_gateway_method_settings" "method" {
+	method_path = "users/GET"
+	settings {
+		throttling_burst_limit = 6000
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsThrottlingRule(),
+					Message: "\"throttling_burst_limit\" should not exceed the account-level limit of 5000.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 28},
+						End:      hcl.Pos{Line: 5, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "configured account limit",
+			Content: `
+resource "aws_api_gateway_method_settings" "method" {
+	method_path = "*/*"
+	settings {
+		throttling_burst_limit = 1000
+		throttling_rate_limit = 100
+	}
+}`,
+			Config: `
+rule "aws_api_gateway_method_settings_throttling_rule" {
+	enabled = true
+	max_burst_limit = 500
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayMethodSettingsThrottlingRule(),
+					Message: "\"throttling_burst_limit\" should not exceed the account-level limit of 500.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 28},
+						End:      hcl.Pos{Line: 5, Column: 32},
+					},
+				},
+			},
+		},
 		{
 			Name: "valid",
 			Content: `
@@ -97,7 +199,11 @@ resource "aws_api_gateway_method_settings" "valid" {
 	rule := NewAwsAPIGatewayMethodSettingsThrottlingRule()
 
 	for _, tc := range cases {
-		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
 
 		if err := rule.Check(runner); err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type awsAPIGatewayMappingSchema struct {
+	apiResourceType     string
+	mappingResourceType string
+}
+
+// AwsAPIGatewayRestAPIExecuteAPIEndpoint checks if APIs mapped to a custom domain disable the default execute-api endpoint
+type AwsAPIGatewayRestAPIExecuteAPIEndpointRule struct {
+	tflint.DefaultRule
+	apiIDAttrName string
+	attributeName string
+	mappings      []awsAPIGatewayMappingSchema
+}
+
+// NewAwsAPIGatewayRestAPIExecuteAPIEndpointRule returns new rule with default attributes
+func NewAwsAPIGatewayRestAPIExecuteAPIEndpointRule() *AwsAPIGatewayRestAPIExecuteAPIEndpointRule {
+	return &AwsAPIGatewayRestAPIExecuteAPIEndpointRule{
+		apiIDAttrName: "api_id",
+		attributeName: "disable_execute_api_endpoint",
+		mappings: []awsAPIGatewayMappingSchema{
+			{
+				apiResourceType:     "aws_api_gateway_rest_api",
+				mappingResourceType: "aws_api_gateway_base_path_mapping",
+			},
+			{
+				apiResourceType:     "aws_apigatewayv2_api",
+				mappingResourceType: "aws_apigatewayv2_api_mapping",
+			},
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayRestAPIExecuteAPIEndpointRule) Name() string {
+	return "aws_api_gateway_rest_api_execute_api_endpoint"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayRestAPIExecuteAPIEndpointRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayRestAPIExecuteAPIEndpointRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayRestAPIExecuteAPIEndpointRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/custom_domain/"
+}
+
+// Check checks if APIs mapped to a custom domain disable the default execute-api endpoint
+func (r *AwsAPIGatewayRestAPIExecuteAPIEndpointRule) Check(runner tflint.Runner) error {
+	for _, mapping := range r.mappings {
+		// Gather APIs with a custom domain
+		mapped := make(map[string]bool)
+		resources, err := runner.GetResourceContent(mapping.mappingResourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: r.apiIDAttrName},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attr, ok := resource.Body.Attributes[r.apiIDAttrName]
+			if !ok {
+				continue
+			}
+			if apiName, ok := resourceReference(attr.Expr, mapping.apiResourceType); ok {
+				mapped[apiName] = true
+			}
+		}
+
+		resources, err = runner.GetResourceContent(mapping.apiResourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: r.attributeName},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			if !mapped[resource.Labels[1]] {
+				continue
+			}
+
+			attr, ok := resource.Body.Attributes[r.attributeName]
+			if !ok {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present for an API with a custom domain.", r.attributeName),
+					resource.DefRange,
+				)
+				continue
+			}
+
+			if !isStaticExpr(attr.Expr) {
+				continue
+			}
+
+			var disabled bool
+			if err := runner.EvaluateExpr(attr.Expr, &disabled, nil); err != nil {
+				return err
+			}
+
+			if !disabled {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should be set to true for an API with a custom domain.", r.attributeName),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayRestAPIExecuteAPIEndpoint(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "mapped api with default endpoint",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+}
+
+resource "aws_api_gateway_base_path_mapping" "this" {
+	api_id = aws_api_gateway_rest_api.this.id
+	domain_name = aws_api_gateway_domain_name.this.domain_name
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIExecuteAPIEndpointRule(),
+					Message: "\"disable_execute_api_endpoint\" is not present for an API with a custom domain.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "mapped http api with endpoint enabled",
+			Content: `
+resource "aws_apigatewayv2_api" "this" {
+	name = "my-api"
+	disable_execute_api_endpoint = false
+}
+
+resource "aws_apigatewayv2_api_mapping" "this" {
+	api_id = aws_apigatewayv2_api.this.id
+	domain_name = aws_apigatewayv2_domain_name.this.id
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIExecuteAPIEndpointRule(),
+					Message: "\"disable_execute_api_endpoint\" should be set to true for an API with a custom domain.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 33},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	disable_execute_api_endpoint = true
+}
+
+resource "aws_api_gateway_base_path_mapping" "this" {
+	api_id = aws_api_gateway_rest_api.this.id
+	domain_name = aws_api_gateway_domain_name.this.domain_name
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "api without custom domain",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayRestAPIExecuteAPIEndpointRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAPIGatewayRestAPIPrivatePolicy checks if private REST APIs have a resource policy restricting access to VPCs
+type AwsAPIGatewayRestAPIPrivatePolicyRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	policyResourceType string
+	restAPIAttrName    string
+	policyAttrName     string
+	conditionKeys      []string
+	denyOperators      []string
+}
+
+// NewAwsAPIGatewayRestAPIPrivatePolicyRule returns new rule with default attributes
+func NewAwsAPIGatewayRestAPIPrivatePolicyRule() *AwsAPIGatewayRestAPIPrivatePolicyRule {
+	return &AwsAPIGatewayRestAPIPrivatePolicyRule{
+		resourceType:       apiGatewayRestAPIType,
+		policyResourceType: "aws_api_gateway_rest_api_policy",
+		restAPIAttrName:    "rest_api_id",
+		policyAttrName:     "policy",
+		conditionKeys: []string{
+			"aws:SourceVpce",
+			"aws:SourceVpc",
+		},
+		denyOperators: []string{
+			"StringNotEquals",
+			"StringNotEqualsIfExists",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayRestAPIPrivatePolicyRule) Name() string {
+	return "aws_api_gateway_rest_api_private_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayRestAPIPrivatePolicyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayRestAPIPrivatePolicyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayRestAPIPrivatePolicyRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/private_policy/"
+}
+
+// checkPolicy checks if a resource policy restricts access to VPCs
+func (r *AwsAPIGatewayRestAPIPrivatePolicyRule) checkPolicy(runner tflint.Runner, attr *hclext.Attribute) error {
+	// Policies built from other resources cannot be inspected
+	if !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var document string
+	if err := runner.EvaluateExpr(attr.Expr, &document, nil); err != nil {
+		return err
+	}
+
+	policy, err := parseIamPolicy(document)
+	if err != nil {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not a valid IAM policy document.", r.policyAttrName),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	wildcardPrincipal := false
+	for _, statement := range policy.Statement {
+		// A "Deny" statement can reject requests from other VPCs for the whole policy
+		if statement.Effect == "Deny" {
+			for _, key := range r.conditionKeys {
+				if statement.hasCondition(r.denyOperators, key) {
+					return nil
+				}
+			}
+		}
+
+		if statement.Effect == "Allow" && statement.Principal.isWildcard() && !statement.hasConditionKey(r.conditionKeys...) {
+			wildcardPrincipal = true
+		}
+	}
+
+	if wildcardPrincipal {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" allows Principal \"*\" without a \"%s\" or \"%s\" condition.", r.policyAttrName, r.conditionKeys[0], r.conditionKeys[1]),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	if policy.hasConditionKey(r.conditionKeys...) {
+		return nil
+	}
+
+	runner.EmitIssue(
+		r,
+		fmt.Sprintf("\"%s\" does not restrict access with a \"%s\" or \"%s\" condition.", r.policyAttrName, r.conditionKeys[0], r.conditionKeys[1]),
+		attr.Expr.Range(),
+	)
+	return nil
+}
+
+// Check checks if private REST APIs have a resource policy restricting access to VPCs
+func (r *AwsAPIGatewayRestAPIPrivatePolicyRule) Check(runner tflint.Runner) error {
+	// Gather standalone resource policies
+	policies := make(map[string][]*hclext.Attribute)
+	resources, err := runner.GetResourceContent(r.policyResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.restAPIAttrName},
+			{Name: r.policyAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		apiAttr, ok := resource.Body.Attributes[r.restAPIAttrName]
+		if !ok {
+			continue
+		}
+		apiName, ok := resourceReference(apiAttr.Expr, r.resourceType)
+		if !ok {
+			continue
+		}
+		if policyAttr, ok := resource.Body.Attributes[r.policyAttrName]; ok {
+			policies[apiName] = append(policies[apiName], policyAttr)
+		}
+	}
+
+	privateAPIs, err := apiGatewayPrivateRestAPIs(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err = runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.policyAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		// Only private APIs are relevant
+		if !privateAPIs[resource.Labels[1]] {
+			continue
+		}
+
+		apiPolicies := policies[resource.Labels[1]]
+		if attr, ok := resource.Body.Attributes[r.policyAttrName]; ok {
+			apiPolicies = append(apiPolicies, attr)
+		}
+
+		if len(apiPolicies) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present for a private API.", r.policyAttrName),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		for _, attr := range apiPolicies {
+			if err := r.checkPolicy(runner, attr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
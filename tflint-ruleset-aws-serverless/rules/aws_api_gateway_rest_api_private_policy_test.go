@@ -0,0 +1,243 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayRestAPIPrivatePolicy(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing policy",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["PRIVATE"]
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIPrivatePolicyRule(),
+					Message: "\"policy\" is not present for a private API.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard principal without vpc condition",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["PRIVATE"]
+	}
+	policy = <<EOF
+{
+	"Version": "2012-10-17",
+	"Statement": {
+		"Effect": "Allow",
+		"Principal": "*",
+		"Action": "execute-api:Invoke",
+		"Resource": "execute-api:/*"
+	}
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIPrivatePolicyRule(),
+					Message: "\"policy\" allows Principal \"*\" without a \"aws:SourceVpce\" or \"aws:SourceVpc\" condition.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 17, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "standalone policy without vpc condition",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["PRIVATE"]
+	}
+}
+
+resource "aws_api_gateway_rest_api_policy" "this" {
+	rest_api_id = aws_api_gateway_rest_api.this.id
+	policy = <<EOF
+{
+	"Version": "2012-10-17",
+	"Statement": [{
+		"Effect": "Allow",
+		"Principal": {"AWS": ["arn:aws:iam::111122223333:root"]},
+		"Action": "execute-api:Invoke",
+		"Resource": "execute-api:/*"
+	}]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIPrivatePolicyRule(),
+					Message: "\"policy\" does not restrict access with a \"aws:SourceVpce\" or \"aws:SourceVpc\" condition.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 11},
+						End:      hcl.Pos{Line: 21, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["PRIVATE"]
+	}
+	policy = <<EOF
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Effect": "Allow",
+			"Principal": "*",
+			"Action": "execute-api:Invoke",
+			"Resource": "execute-api:/*"
+		},
+		{
+			"Effect": "Deny",
+			"Principal": "*",
+			"Action": "execute-api:Invoke",
+			"Resource": "execute-api:/*",
+			"Condition": {
+				"StringNotEquals": {
+					"aws:sourceVpce": "vpce-1a2b3c4d"
+				}
+			}
+		}
+	]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "wildcard principal with vpc condition on another statement",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["PRIVATE"]
+	}
+	policy = <<EOF
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Effect": "Allow",
+			"Principal": "*",
+			"Action": "execute-api:Invoke",
+			"Resource": "execute-api:/*"
+		},
+		{
+			"Effect": "Allow",
+			"Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+			"Action": "execute-api:Invoke",
+			"Resource": "execute-api:/*",
+			"Condition": {
+				"StringEquals": {
+					"aws:SourceVpc": "vpc-1a2b3c4d"
+				}
+			}
+		}
+	]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIPrivatePolicyRule(),
+					Message: "\"policy\" allows Principal \"*\" without a \"aws:SourceVpce\" or \"aws:SourceVpc\" condition.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 30, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard principal with vpc condition",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["PRIVATE"]
+	}
+	policy = <<EOF
+{
+	"Version": "2012-10-17",
+	"Statement": {
+		"Effect": "Allow",
+		"Principal": "*",
+		"Action": "execute-api:Invoke",
+		"Resource": "execute-api:/*",
+		"Condition": {
+			"StringEquals": {
+				"aws:SourceVpce": "vpce-1a2b3c4d"
+			}
+		}
+	}
+}
+EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "regional api",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["REGIONAL"]
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayRestAPIPrivatePolicyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
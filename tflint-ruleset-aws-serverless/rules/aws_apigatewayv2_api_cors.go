@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsApigatewayV2APICors checks if HTTP APIs have a restrictive CORS configuration
+type AwsApigatewayV2APICorsRule struct {
+	tflint.DefaultRule
+	resourceType         string
+	blockName            string
+	originsAttrName      string
+	credentialsAttrName  string
+	headersAttrName      string
+	methodsAttrName      string
+	maxAgeAttrName       string
+	broadValuesAttrNames []string
+}
+
+// NewAwsApigatewayV2APICorsRule returns new rule with default attributes
+func NewAwsApigatewayV2APICorsRule() *AwsApigatewayV2APICorsRule {
+	return &AwsApigatewayV2APICorsRule{
+		resourceType:        "aws_apigatewayv2_api",
+		blockName:           "cors_configuration",
+		originsAttrName:     "allow_origins",
+		credentialsAttrName: "allow_credentials",
+		headersAttrName:     "allow_headers",
+		methodsAttrName:     "allow_methods",
+		maxAgeAttrName:      "max_age",
+		broadValuesAttrNames: []string{
+			"allow_headers",
+			"allow_methods",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsApigatewayV2APICorsRule) Name() string {
+	return "aws_apigatewayv2_api_cors"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsApigatewayV2APICorsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsApigatewayV2APICorsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsApigatewayV2APICorsRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/cors/"
+}
+
+// hasWildcard returns true if the attribute is a list containing "*"
+func (r *AwsApigatewayV2APICorsRule) hasWildcard(runner tflint.Runner, attr *hclext.Attribute) (bool, error) {
+	if !isStaticExpr(attr.Expr) {
+		return false, nil
+	}
+
+	var values []string
+	if err := runner.EvaluateExpr(attr.Expr, &values, nil); err != nil {
+		return false, err
+	}
+
+	for _, value := range values {
+		if value == "*" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Check checks if HTTP APIs have a restrictive CORS configuration
+func (r *AwsApigatewayV2APICorsRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.originsAttrName},
+						{Name: r.credentialsAttrName},
+						{Name: r.headersAttrName},
+						{Name: r.methodsAttrName},
+						{Name: r.maxAgeAttrName},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, block := range resource.Body.Blocks {
+			// Wildcard origins with credentials
+			if originsAttr, ok := block.Body.Attributes[r.originsAttrName]; ok {
+				wildcard, err := r.hasWildcard(runner, originsAttr)
+				if err != nil {
+					return err
+				}
+
+				credentialsAttr, ok := block.Body.Attributes[r.credentialsAttrName]
+				if wildcard && ok && isStaticExpr(credentialsAttr.Expr) {
+					var credentials bool
+					if err := runner.EvaluateExpr(credentialsAttr.Expr, &credentials, nil); err != nil {
+						return err
+					}
+
+					if credentials {
+						runner.EmitIssue(
+							r,
+							fmt.Sprintf("\"%s\" should not contain \"*\" when \"%s\" is true.", r.originsAttrName, r.credentialsAttrName),
+							originsAttr.Expr.Range(),
+						)
+					}
+				}
+			}
+
+			// Overly broad headers and methods
+			for _, attrName := range r.broadValuesAttrNames {
+				attr, ok := block.Body.Attributes[attrName]
+				if !ok {
+					continue
+				}
+
+				wildcard, err := r.hasWildcard(runner, attr)
+				if err != nil {
+					return err
+				}
+				if wildcard {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("\"%s\" should list explicit values instead of \"*\".", attrName),
+						attr.Expr.Range(),
+					)
+				}
+			}
+
+			if _, ok := block.Body.Attributes[r.maxAgeAttrName]; !ok {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present.", r.maxAgeAttrName),
+					block.DefRange,
+				)
+			}
+		}
+	}
+
+	return nil
+}
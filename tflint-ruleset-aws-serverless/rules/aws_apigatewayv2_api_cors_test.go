@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsApigatewayV2APICors(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "wildcard origins with credentials",
+			Content: `
+resource "aws_apigatewayv2_api" "this" {
+	name = "my-api"
+	cors_configuration {
+		allow_origins = ["*"]
+		allow_credentials = true
+		max_age = 300
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsApigatewayV2APICorsRule(),
+					Message: "\"allow_origins\" should not contain \"*\" when \"allow_credentials\" is true.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 19},
+						End:      hcl.Pos{Line: 5, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "broad headers and methods without max_age",
+			Content: `
+resource "aws_apigatewayv2_api" "this" {
+	name = "my-api"
+	cors_configuration {
+		allow_origins = ["https://example.com"]
+		allow_headers = ["*"]
+		allow_methods = ["GET", "*"]
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsApigatewayV2APICorsRule(),
+					Message: "\"allow_headers\" should list explicit values instead of \"*\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 19},
+						End:      hcl.Pos{Line: 6, Column: 24},
+					},
+				},
+				{
+					Rule:    NewAwsApigatewayV2APICorsRule(),
+					Message: "\"allow_methods\" should list explicit values instead of \"*\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 19},
+						End:      hcl.Pos{Line: 7, Column: 31},
+					},
+				},
+				{
+					Rule:    NewAwsApigatewayV2APICorsRule(),
+					Message: "\"max_age\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 2},
+						End:      hcl.Pos{Line: 4, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_apigatewayv2_api" "this" {
+	name = "my-api"
+	cors_configuration {
+		allow_origins = ["https://example.com"]
+		allow_credentials = true
+		allow_headers = ["content-type", "authorization"]
+		allow_methods = ["GET", "POST"]
+		max_age = 300
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsApigatewayV2APICorsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
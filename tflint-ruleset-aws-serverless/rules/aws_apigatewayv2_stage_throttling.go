@@ -7,11 +7,12 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
-// AwsApigatewayV2StageThrottlingRule checks whether "aws_apigatewayv2_stage" has default throttling values.
+// AwsApigatewayV2StageThrottlingRule checks whether "aws_apigatewayv2_stage" has valid default throttling values.
 type AwsApigatewayV2StageThrottlingRule struct {
 	tflint.DefaultRule
 	resourceType       string
 	blockName          string
+	routeBlockName     string
 	burstAttributeName string
 	rateAttributeName  string
 }
@@ -21,8 +22,9 @@ func NewAwsApigatewayV2StageThrottlingRule() *AwsApigatewayV2StageThrottlingRule
 	return &AwsApigatewayV2StageThrottlingRule{
 		resourceType:       "aws_apigatewayv2_stage",
 		blockName:          "default_route_settings",
-		burstAttributeName: "throttling_burst_limit",
-		rateAttributeName:  "throttling_rate_limit",
+		routeBlockName:     "route_settings",
+		burstAttributeName: throttlingBurstAttrName,
+		rateAttributeName:  throttlingRateAttrName,
 	}
 }
 
@@ -46,26 +48,44 @@ func (r *AwsApigatewayV2StageThrottlingRule) Link() string {
 	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/default_throttling/"
 }
 
-// Check checks whether "aws_apigatewayv2_stage" has has default throttling values
+// Check checks whether "aws_apigatewayv2_stage" has default throttling values,
+// and that throttling values for all routes are within bounds
 func (r *AwsApigatewayV2StageThrottlingRule) Check(runner tflint.Runner) error {
+	config := newAwsThrottlingConfig()
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return fmt.Errorf("failed to decode rule config: %w", err)
+	}
+
+	settingsSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.burstAttributeName},
+			{Name: r.rateAttributeName},
+		},
+	}
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Blocks: []hclext.BlockSchema{
 			{
 				Type: r.blockName,
-				Body: &hclext.BodySchema{
-					Attributes: []hclext.AttributeSchema{
-						{Name: r.burstAttributeName},
-						{Name: r.rateAttributeName},
-					},
-				},
+				Body: settingsSchema,
+			},
+			{
+				Type: r.routeBlockName,
+				Body: settingsSchema,
 			},
 		},
 	}, nil)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get resource content: %w", err)
 	}
 
 	for _, resource := range resources.Blocks {
+		// Check values for per-route settings
+		for _, block := range resource.Body.Blocks.OfType(r.routeBlockName) {
+			if err := checkThrottlingLimits(runner, r, block.Body, config); err != nil {
+				return fmt.Errorf("failed to evaluate throttling limits: %w", err)
+			}
+		}
+
 		// Check for block
 		blocks := resource.Body.Blocks.OfType(r.blockName)
 		if len(blocks) == 0 {
@@ -95,6 +115,10 @@ func (r *AwsApigatewayV2StageThrottlingRule) Check(runner tflint.Runner) error {
 				blocks[0].DefRange,
 			)
 		}
+
+		if err := checkThrottlingLimits(runner, r, blocks[0].Body, config); err != nil {
+			return fmt.Errorf("failed to evaluate throttling limits: %w", err)
+		}
 	}
 
 	return nil
@@ -77,6 +77,33 @@ resource "aws_apigatewayv2_stage" "missingrate" {
 				},
 			},
 		},
+		{
+			Name: "invalid route settings",
+			Content: `
+resource "aws_apigatewayv2_stage" "routes" {
+	default_route_settings {
+		throttling_burst_limit = 1000
+		throttling_rate_limit = 100
+	}
+
+	route_settings {
+		route_key = "GET /users"
+		throttling_burst_limit = 50
+		throttling_rate_limit = 100
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsApigatewayV2StageThrottlingRule(),
+					Message: "\"throttling_burst_limit\" should be greater than or equal to \"throttling_rate_limit\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 28},
+						End:      hcl.Pos{Line: 10, Column: 30},
+					},
+				},
+			},
+		},
 		{
 			Name: "valid",
 			Content: `
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// awsAppsyncAPIKeyExpiryConfig is the rule configuration for AppSync API key expiry
+type awsAppsyncAPIKeyExpiryConfig struct {
+	MaxExpiryDays int `hclext:"max_expiry_days,optional"`
+}
+
+// AwsAppsyncAPIKeyExpiry checks if AppSync API keys have an explicit and bounded expiry date
+type AwsAppsyncAPIKeyExpiryRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	now           func() time.Time
+}
+
+// NewAwsAppsyncAPIKeyExpiryRule returns new rule with default attributes
+func NewAwsAppsyncAPIKeyExpiryRule() *AwsAppsyncAPIKeyExpiryRule {
+	return &AwsAppsyncAPIKeyExpiryRule{
+		resourceType:  "aws_appsync_api_key",
+		attributeName: "expires",
+		now:           time.Now,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncAPIKeyExpiryRule) Name() string {
+	return "aws_appsync_api_key_expiry"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncAPIKeyExpiryRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncAPIKeyExpiryRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncAPIKeyExpiryRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/authorization/"
+}
+
+// Check checks if AppSync API keys have an explicit and bounded expiry date
+func (r *AwsAppsyncAPIKeyExpiryRule) Check(runner tflint.Runner) error {
+	config := &awsAppsyncAPIKeyExpiryConfig{
+		MaxExpiryDays: 90,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.attributeName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.attributeName),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		// Expiry dates are usually computed with timeadd(timestamp(), ...)
+		if !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var expires string
+		if err := runner.EvaluateExpr(attr.Expr, &expires, nil); err != nil {
+			return err
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339, expires)
+		if err != nil {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not a valid RFC3339 timestamp.", r.attributeName),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+
+		now := r.now()
+		if expiresAt.Before(now) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is in the past.", r.attributeName),
+				attr.Expr.Range(),
+			)
+		} else if expiresAt.After(now.AddDate(0, 0, config.MaxExpiryDays)) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be within %d days.", r.attributeName, config.MaxExpiryDays),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
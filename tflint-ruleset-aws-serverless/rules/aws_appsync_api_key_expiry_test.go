@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncAPIKeyExpiry(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing expires",
+			Content: `
+resource "aws_appsync_api_key" "this" {
+	api_id = aws_appsync_graphql_api.this.id
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncAPIKeyExpiryRule(),
+					Message: "\"expires\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "expires too far",
+			Content: `
+resource "aws_appsync_api_key" "this" {
+	api_id  = aws_appsync_graphql_api.this.id
+	expires = "2022-12-31T00:00:00Z"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncAPIKeyExpiryRule(),
+					Message: "\"expires\" should be within 90 days.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "expires in the past",
+			Content: `
+resource "aws_appsync_api_key" "this" {
+	api_id  = aws_appsync_graphql_api.this.id
+	expires = "2021-12-31T00:00:00Z"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncAPIKeyExpiryRule(),
+					Message: "\"expires\" is in the past.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid timestamp",
+			Content: `
+resource "aws_appsync_api_key" "this" {
+	api_id  = aws_appsync_graphql_api.this.id
+	expires = "2022-02-01"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncAPIKeyExpiryRule(),
+					Message: "\"expires\" is not a valid RFC3339 timestamp.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "expires within custom horizon",
+			Content: `
+resource "aws_appsync_api_key" "this" {
+	api_id  = aws_appsync_graphql_api.this.id
+	expires = "2022-12-31T00:00:00Z"
+}
+`,
+			Config: `
+rule "aws_appsync_api_key_expiry" {
+	enabled         = true
+	max_expiry_days = 365
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_appsync_api_key" "this" {
+	api_id  = aws_appsync_graphql_api.this.id
+	expires = "2022-02-01T00:00:00Z"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "computed expires",
+			Content: `
+resource "aws_appsync_api_key" "this" {
+	api_id  = aws_appsync_graphql_api.this.id
+	expires = time_offset.this.rfc3339
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncAPIKeyExpiryRule()
+	rule.now = func() time.Time {
+		return time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAppsyncDatasourceServiceRole checks if AppSync data sources calling AWS services have a service role
+type AwsAppsyncDatasourceServiceRoleRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	typeAttrName  string
+	roleAttrName  string
+	roleRequiring map[string]bool
+}
+
+// NewAwsAppsyncDatasourceServiceRoleRule returns new rule with default attributes
+func NewAwsAppsyncDatasourceServiceRoleRule() *AwsAppsyncDatasourceServiceRoleRule {
+	return &AwsAppsyncDatasourceServiceRoleRule{
+		resourceType: "aws_appsync_datasource",
+		typeAttrName: "type",
+		roleAttrName: "service_role_arn",
+		roleRequiring: map[string]bool{
+			"AWS_LAMBDA":                true,
+			"AMAZON_DYNAMODB":           true,
+			"AMAZON_ELASTICSEARCH":      true,
+			"AMAZON_OPENSEARCH_SERVICE": true,
+			"AMAZON_EVENTBRIDGE":        true,
+			"RELATIONAL_DATABASE":       true,
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncDatasourceServiceRoleRule) Name() string {
+	return "aws_appsync_datasource_service_role"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncDatasourceServiceRoleRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncDatasourceServiceRoleRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncDatasourceServiceRoleRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/resolvers/"
+}
+
+// Check checks if AppSync data sources calling AWS services have a service role
+func (r *AwsAppsyncDatasourceServiceRoleRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.typeAttrName},
+			{Name: r.roleAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if _, ok := resource.Body.Attributes[r.roleAttrName]; ok {
+			continue
+		}
+
+		attr, ok := resource.Body.Attributes[r.typeAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var dataSourceType string
+		if err := runner.EvaluateExpr(attr.Expr, &dataSourceType, nil); err != nil {
+			return err
+		}
+
+		if r.roleRequiring[dataSourceType] {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is required for %s data sources.", r.roleAttrName, dataSourceType),
+				resource.DefRange,
+			)
+		}
+	}
+
+	return nil
+}
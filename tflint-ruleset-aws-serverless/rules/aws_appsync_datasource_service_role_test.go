@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncDatasourceServiceRole(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "lambda without role",
+			Content: `
+resource "aws_appsync_datasource" "this" {
+	api_id = aws_appsync_graphql_api.this.id
+	name   = "lambda"
+	type   = "AWS_LAMBDA"
+
+	lambda_config {
+		function_arn = aws_lambda_function.this.arn
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncDatasourceServiceRoleRule(),
+					Message: "\"service_role_arn\" is required for AWS_LAMBDA data sources.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "dynamodb with role",
+			Content: `
+resource "aws_appsync_datasource" "this" {
+	api_id           = aws_appsync_graphql_api.this.id
+	name             = "table"
+	type             = "AMAZON_DYNAMODB"
+	service_role_arn = aws_iam_role.this.arn
+
+	dynamodb_config {
+		table_name = aws_dynamodb_table.this.name
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "none data source",
+			Content: `
+resource "aws_appsync_datasource" "this" {
+	api_id = aws_appsync_graphql_api.this.id
+	name   = "local"
+	type   = "NONE"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncDatasourceServiceRoleRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
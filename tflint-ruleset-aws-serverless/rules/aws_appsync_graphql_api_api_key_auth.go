@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAppsyncGraphqlAPIAPIKeyAuth checks if AppSync APIs use API keys as their only authorization mode
+type AwsAppsyncGraphqlAPIAPIKeyAuthRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	attributeName   string
+	additionalBlock string
+	apiKeyType      string
+}
+
+// NewAwsAppsyncGraphqlAPIAPIKeyAuthRule returns new rule with default attributes
+func NewAwsAppsyncGraphqlAPIAPIKeyAuthRule() *AwsAppsyncGraphqlAPIAPIKeyAuthRule {
+	return &AwsAppsyncGraphqlAPIAPIKeyAuthRule{
+		resourceType:    "aws_appsync_graphql_api",
+		attributeName:   "authentication_type",
+		additionalBlock: "additional_authentication_provider",
+		apiKeyType:      "API_KEY",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncGraphqlAPIAPIKeyAuthRule) Name() string {
+	return "aws_appsync_graphql_api_api_key_auth"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncGraphqlAPIAPIKeyAuthRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncGraphqlAPIAPIKeyAuthRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncGraphqlAPIAPIKeyAuthRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/authorization/"
+}
+
+// Check checks if AppSync APIs use API keys as their only authorization mode
+func (r *AwsAppsyncGraphqlAPIAPIKeyAuthRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.additionalBlock,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.attributeName},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.attributeName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var authType string
+		if err := runner.EvaluateExpr(attr.Expr, &authType, nil); err != nil {
+			return err
+		}
+		if authType != r.apiKeyType {
+			continue
+		}
+
+		// API keys are fine as a complement to another authorization mode
+		soleMode := true
+		for _, block := range resource.Body.Blocks {
+			additionalAttr, ok := block.Body.Attributes[r.attributeName]
+			if !ok || !isStaticExpr(additionalAttr.Expr) {
+				soleMode = false
+				break
+			}
+
+			var additionalType string
+			if err := runner.EvaluateExpr(additionalAttr.Expr, &additionalType, nil); err != nil {
+				return err
+			}
+			if additionalType != r.apiKeyType {
+				soleMode = false
+				break
+			}
+		}
+
+		if soleMode {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should not be %s without another authorization mode.", r.attributeName, r.apiKeyType),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncGraphqlAPIAPIKeyAuth(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "api key only",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "API_KEY"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIAPIKeyAuthRule(),
+					Message: "\"authentication_type\" should not be API_KEY without another authorization mode.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 4, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "api key with additional api key",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "API_KEY"
+
+	additional_authentication_provider {
+		authentication_type = "API_KEY"
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIAPIKeyAuthRule(),
+					Message: "\"authentication_type\" should not be API_KEY without another authorization mode.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 4, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "api key with additional provider",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "API_KEY"
+
+	additional_authentication_provider {
+		authentication_type = "AWS_IAM"
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "iam",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "AWS_IAM"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncGraphqlAPIAPIKeyAuthRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
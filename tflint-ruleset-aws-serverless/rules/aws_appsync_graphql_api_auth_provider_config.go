@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type awsAppsyncAuthProviderSchema struct {
+	blockName     string
+	attributeName string
+}
+
+// AwsAppsyncGraphqlAPIAuthProviderConfig checks if AppSync authorization modes have their required configuration
+type AwsAppsyncGraphqlAPIAuthProviderConfigRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	attributeName   string
+	additionalBlock string
+	providers       map[string]awsAppsyncAuthProviderSchema
+}
+
+// NewAwsAppsyncGraphqlAPIAuthProviderConfigRule returns new rule with default attributes
+func NewAwsAppsyncGraphqlAPIAuthProviderConfigRule() *AwsAppsyncGraphqlAPIAuthProviderConfigRule {
+	return &AwsAppsyncGraphqlAPIAuthProviderConfigRule{
+		resourceType:    "aws_appsync_graphql_api",
+		attributeName:   "authentication_type",
+		additionalBlock: "additional_authentication_provider",
+		providers: map[string]awsAppsyncAuthProviderSchema{
+			"AMAZON_COGNITO_USER_POOLS": {
+				blockName:     "user_pool_config",
+				attributeName: "user_pool_id",
+			},
+			"OPENID_CONNECT": {
+				blockName:     "openid_connect_config",
+				attributeName: "issuer",
+			},
+			"AWS_LAMBDA": {
+				blockName:     "lambda_authorizer_config",
+				attributeName: "authorizer_uri",
+			},
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncGraphqlAPIAuthProviderConfigRule) Name() string {
+	return "aws_appsync_graphql_api_auth_provider_config"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncGraphqlAPIAuthProviderConfigRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncGraphqlAPIAuthProviderConfigRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncGraphqlAPIAuthProviderConfigRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/authorization/"
+}
+
+// providerSchema returns the schema of an authorization mode and its nested configuration blocks
+func (r *AwsAppsyncGraphqlAPIAuthProviderConfigRule) providerSchema() *hclext.BodySchema {
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+	}
+
+	for _, provider := range r.providers {
+		schema.Blocks = append(schema.Blocks, hclext.BlockSchema{
+			Type: provider.blockName,
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{
+					{Name: provider.attributeName},
+				},
+			},
+		})
+	}
+
+	return schema
+}
+
+// checkProvider checks if an authorization mode has its required configuration block
+func (r *AwsAppsyncGraphqlAPIAuthProviderConfigRule) checkProvider(runner tflint.Runner, block *hclext.Block) error {
+	attr, ok := block.Body.Attributes[r.attributeName]
+	if !ok || !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var authType string
+	if err := runner.EvaluateExpr(attr.Expr, &authType, nil); err != nil {
+		return err
+	}
+
+	provider, ok := r.providers[authType]
+	if !ok {
+		return nil
+	}
+
+	configBlocks := block.Body.Blocks.OfType(provider.blockName)
+	if len(configBlocks) == 0 {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is required for %s.", provider.blockName, authType),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	if _, ok := configBlocks[0].Body.Attributes[provider.attributeName]; !ok {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", provider.attributeName),
+			configBlocks[0].DefRange,
+		)
+	}
+
+	return nil
+}
+
+// Check checks if AppSync authorization modes have their required configuration
+func (r *AwsAppsyncGraphqlAPIAuthProviderConfigRule) Check(runner tflint.Runner) error {
+	schema := r.providerSchema()
+	schema.Blocks = append(schema.Blocks, hclext.BlockSchema{
+		Type: r.additionalBlock,
+		Body: r.providerSchema(),
+	})
+
+	resources, err := runner.GetResourceContent(r.resourceType, schema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		// Default authorization mode
+		if err := r.checkProvider(runner, resource); err != nil {
+			return err
+		}
+
+		for _, block := range resource.Body.Blocks.OfType(r.additionalBlock) {
+			if err := r.checkProvider(runner, block); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncGraphqlAPIAuthProviderConfig(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing user_pool_config",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "AMAZON_COGNITO_USER_POOLS"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIAuthProviderConfigRule(),
+					Message: "\"user_pool_config\" is required for AMAZON_COGNITO_USER_POOLS.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 4, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "additional providers without config",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "AWS_IAM"
+
+	additional_authentication_provider {
+		authentication_type = "OPENID_CONNECT"
+	}
+
+	additional_authentication_provider {
+		authentication_type = "AWS_LAMBDA"
+
+		lambda_authorizer_config {
+			authorizer_result_ttl_in_seconds = 300
+		}
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIAuthProviderConfigRule(),
+					Message: "\"openid_connect_config\" is required for OPENID_CONNECT.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 25},
+						End:      hcl.Pos{Line: 7, Column: 41},
+					},
+				},
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIAuthProviderConfigRule(),
+					Message: "\"authorizer_uri\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 13, Column: 3},
+						End:      hcl.Pos{Line: 13, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "AMAZON_COGNITO_USER_POOLS"
+
+	user_pool_config {
+		default_action = "DENY"
+		user_pool_id   = aws_cognito_user_pool.this.id
+	}
+
+	additional_authentication_provider {
+		authentication_type = "OPENID_CONNECT"
+
+		openid_connect_config {
+			issuer = "https://example.com"
+		}
+	}
+
+	additional_authentication_provider {
+		authentication_type = "AWS_IAM"
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncGraphqlAPIAuthProviderConfigRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// awsAppsyncLoggingConfig is the rule configuration for AppSync logging
+type awsAppsyncLoggingConfig struct {
+	AllowLevelAll bool `hclext:"allow_level_all,optional"`
+}
+
+// AwsAppsyncGraphqlAPILogging checks whether "aws_appsync_graphql_api" has logging enabled
+type AwsAppsyncGraphqlAPILoggingRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	blockName       string
+	levelAttrName   string
+	roleAttrName    string
+	verboseAttrName string
+	disabledLevel   string
+	allLevel        string
+}
+
+// NewAwsAppsyncGraphqlAPILoggingRule returns new rule with default attributes
+func NewAwsAppsyncGraphqlAPILoggingRule() *AwsAppsyncGraphqlAPILoggingRule {
+	return &AwsAppsyncGraphqlAPILoggingRule{
+		resourceType:    "aws_appsync_graphql_api",
+		blockName:       "log_config",
+		levelAttrName:   "field_log_level",
+		roleAttrName:    "cloudwatch_logs_role_arn",
+		verboseAttrName: "exclude_verbose_content",
+		disabledLevel:   "NONE",
+		allLevel:        "ALL",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncGraphqlAPILoggingRule) Name() string {
+	return "aws_appsync_graphql_api_logging"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncGraphqlAPILoggingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncGraphqlAPILoggingRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncGraphqlAPILoggingRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/logging/"
+}
+
+// Check checks whether "aws_appsync_graphql_api" has logging enabled
+func (r *AwsAppsyncGraphqlAPILoggingRule) Check(runner tflint.Runner) error {
+	config := &awsAppsyncLoggingConfig{
+		AllowLevelAll: true,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.levelAttrName},
+						{Name: r.roleAttrName},
+						{Name: r.verboseAttrName},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		blocks := resource.Body.Blocks.OfType(r.blockName)
+		if len(blocks) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.blockName),
+				resource.DefRange,
+			)
+			continue
+		}
+		block := blocks[0]
+
+		if _, ok := block.Body.Attributes[r.roleAttrName]; !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.roleAttrName),
+				block.DefRange,
+			)
+		}
+
+		// Check log level
+		if attr, ok := block.Body.Attributes[r.levelAttrName]; ok && isStaticExpr(attr.Expr) {
+			var level string
+			if err := runner.EvaluateExpr(attr.Expr, &level, nil); err != nil {
+				return err
+			}
+
+			if level == r.disabledLevel {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should not be set to %s.", r.levelAttrName, r.disabledLevel),
+					attr.Expr.Range(),
+				)
+			} else if level == r.allLevel && !config.AllowLevelAll {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should not be set to %s.", r.levelAttrName, r.allLevel),
+					attr.Expr.Range(),
+				)
+			}
+		}
+
+		// Check verbose content
+		attr, ok := block.Body.Attributes[r.verboseAttrName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.verboseAttrName),
+				block.DefRange,
+			)
+			continue
+		}
+
+		if !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var excludeVerbose bool
+		if err := runner.EvaluateExpr(attr.Expr, &excludeVerbose, nil); err != nil {
+			return err
+		}
+
+		if !excludeVerbose {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be set to true.", r.verboseAttrName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncGraphqlAPILogging(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing log_config",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name = "my-api"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncGraphqlAPILoggingRule(),
+					Message: "\"log_config\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "logging disabled",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name = "my-api"
+	log_config {
+		field_log_level = "NONE"
+		exclude_verbose_content = false
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncGraphqlAPILoggingRule(),
+					Message: "\"cloudwatch_logs_role_arn\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 2},
+						End:      hcl.Pos{Line: 4, Column: 12},
+					},
+				},
+				{
+					Rule:    NewAwsAppsyncGraphqlAPILoggingRule(),
+					Message: "\"field_log_level\" should not be set to NONE.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 21},
+						End:      hcl.Pos{Line: 5, Column: 27},
+					},
+				},
+				{
+					Rule:    NewAwsAppsyncGraphqlAPILoggingRule(),
+					Message: "\"exclude_verbose_content\" should be set to true.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 29},
+						End:      hcl.Pos{Line: 6, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "level ALL is not allowed",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name = "my-api"
+	log_config {
+		cloudwatch_logs_role_arn = aws_iam_role.this.arn
+		field_log_level = "ALL"
+		exclude_verbose_content = true
+	}
+}
+`,
+			Config: `
+rule "aws_appsync_graphql_api_logging" {
+	enabled = true
+	allow_level_all = false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncGraphqlAPILoggingRule(),
+					Message: "\"field_log_level\" should not be set to ALL.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 21},
+						End:      hcl.Pos{Line: 6, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_appsync_graphql_api" "this" {
+	name = "my-api"
+	log_config {
+		cloudwatch_logs_role_arn = aws_iam_role.this.arn
+		field_log_level = "ALL"
+		exclude_verbose_content = true
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncGraphqlAPILoggingRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,186 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAppsyncGraphqlAPIResolverCoverage checks if resolvers match the fields of the GraphQL schema
+type AwsAppsyncGraphqlAPIResolverCoverageRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	resolverType       string
+	apiIDAttrName      string
+	typeAttrName       string
+	fieldAttrName      string
+	subscribeDirective string
+}
+
+// NewAwsAppsyncGraphqlAPIResolverCoverageRule returns new rule with default attributes
+func NewAwsAppsyncGraphqlAPIResolverCoverageRule() *AwsAppsyncGraphqlAPIResolverCoverageRule {
+	return &AwsAppsyncGraphqlAPIResolverCoverageRule{
+		resourceType:       "aws_appsync_graphql_api",
+		resolverType:       "aws_appsync_resolver",
+		apiIDAttrName:      "api_id",
+		typeAttrName:       "type",
+		fieldAttrName:      "field",
+		subscribeDirective: "aws_subscribe",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncGraphqlAPIResolverCoverageRule) Name() string {
+	return "aws_appsync_graphql_api_resolver_coverage"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncGraphqlAPIResolverCoverageRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncGraphqlAPIResolverCoverageRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncGraphqlAPIResolverCoverageRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/resolver_coverage/"
+}
+
+// Check checks if resolvers match the fields of the GraphQL schema
+func (r *AwsAppsyncGraphqlAPIResolverCoverageRule) Check(runner tflint.Runner) error {
+	apis, err := appsyncAPISchemas(runner)
+	if err != nil {
+		return err
+	}
+
+	schemas := make(map[string]*appsyncSchema)
+	schemaAttrs := make(map[string]*hclext.Attribute)
+	for apiName, api := range apis {
+		// The parser only supports a subset of SDL, so parse errors are not reported
+		if api.parseErr != nil {
+			logger.Debug(fmt.Sprintf("Skipping %s schema that cannot be parsed: %s", apiName, api.parseErr))
+			continue
+		}
+
+		schemas[apiName] = api.schema
+		schemaAttrs[apiName] = api.attr
+	}
+
+	resources, err := runner.GetResourceContent(r.resolverType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.apiIDAttrName},
+			{Name: r.typeAttrName},
+			{Name: r.fieldAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// resolved maps API names to the "Type.field" pairs with a resolver
+	resolved := make(map[string]map[string]bool)
+	// dynamic contains APIs with resolvers on fields that cannot be evaluated statically
+	dynamic := make(map[string]bool)
+
+	for _, resource := range resources.Blocks {
+		apiIDAttr, ok := resource.Body.Attributes[r.apiIDAttrName]
+		if !ok {
+			continue
+		}
+		apiName, ok := resourceReference(apiIDAttr.Expr, r.resourceType)
+		if !ok {
+			continue
+		}
+		schema, ok := schemas[apiName]
+		if !ok {
+			continue
+		}
+
+		typeAttr, ok := resource.Body.Attributes[r.typeAttrName]
+		if !ok || !isStaticExpr(typeAttr.Expr) {
+			dynamic[apiName] = true
+			continue
+		}
+		fieldAttr, ok := resource.Body.Attributes[r.fieldAttrName]
+		if !ok || !isStaticExpr(fieldAttr.Expr) {
+			dynamic[apiName] = true
+			continue
+		}
+
+		var typeName, fieldName string
+		if err := runner.EvaluateExpr(typeAttr.Expr, &typeName, nil); err != nil {
+			return err
+		}
+		if err := runner.EvaluateExpr(fieldAttr.Expr, &fieldName, nil); err != nil {
+			return err
+		}
+
+		fields, ok := schema.types[typeName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not an object type of the schema.", typeName),
+				typeAttr.Expr.Range(),
+			)
+			continue
+		}
+		if _, ok := fields[fieldName]; !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s.%s\" is not a field of the schema.", typeName, fieldName),
+				fieldAttr.Expr.Range(),
+			)
+			continue
+		}
+
+		if _, ok := resolved[apiName]; !ok {
+			resolved[apiName] = make(map[string]bool)
+		}
+		resolved[apiName][typeName+"."+fieldName] = true
+	}
+
+	// Root fields without resolvers
+	apiNames := make([]string, 0, len(schemas))
+	for apiName := range schemas {
+		apiNames = append(apiNames, apiName)
+	}
+	sort.Strings(apiNames)
+
+	for _, apiName := range apiNames {
+		// Resolvers might be managed elsewhere for APIs without any resolver here
+		if dynamic[apiName] || len(resolved[apiName]) == 0 {
+			continue
+		}
+		schema := schemas[apiName]
+
+		missing := []string{}
+		for _, typeName := range schema.rootTypes() {
+			for fieldName, field := range schema.types[typeName] {
+				// Subscriptions triggered by mutations do not need a resolver
+				if field.hasDirective(r.subscribeDirective) {
+					continue
+				}
+				if !resolved[apiName][typeName+"."+fieldName] {
+					missing = append(missing, typeName+"."+fieldName)
+				}
+			}
+		}
+		sort.Strings(missing)
+
+		for _, field := range missing {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" has no resolver.", field),
+				schemaAttrs[apiName].Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncGraphqlAPIResolverCoverage(t *testing.T) {
+	api := `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "AWS_IAM"
+	schema              = <<SCHEMA
+schema {
+	query: RootQuery
+	mutation: RootMutation
+	subscription: RootSubscription
+}
+
+"The root query"
+type RootQuery {
+	getPost(id: ID!): Post @aws_iam
+	listPosts(filter: PostFilter = {status: PUBLISHED}, limit: Int): [Post]
+}
+
+type RootMutation {
+	createPost(input: CreatePostInput!): Post
+}
+
+extend type RootMutation {
+	deletePost(id: ID!): Post
+}
+
+type RootSubscription {
+	onCreatePost: Post @aws_subscribe(mutations: ["createPost"])
+}
+
+interface Node {
+	id: ID!
+}
+
+type Post implements Node & Entity @aws_iam {
+	id: ID!
+	"""
+	Title of the post
+	"""
+	title: String
+	status: Status
+	related: [SearchResult!]!
+}
+
+union SearchResult = Post | Comment
+
+type Comment {
+	id: ID!
+}
+
+enum Status {
+	DRAFT
+	PUBLISHED
+}
+
+input PostFilter {
+	status: Status
+}
+
+input CreatePostInput {
+	title: String!
+}
+
+scalar Entity
+SCHEMA
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "all root fields resolved",
+			Content: `
+resource "aws_appsync_resolver" "get_post" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "RootQuery"
+	field  = "getPost"
+}
+
+resource "aws_appsync_resolver" "list_posts" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "RootQuery"
+	field  = "listPosts"
+}
+
+resource "aws_appsync_resolver" "create_post" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "RootMutation"
+	field  = "createPost"
+}
+
+resource "aws_appsync_resolver" "delete_post" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "RootMutation"
+	field  = "deletePost"
+}
+
+resource "aws_appsync_resolver" "related" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "Post"
+	field  = "related"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing resolvers and unknown fields",
+			Content: `
+resource "aws_appsync_resolver" "get_post" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "RootQuery"
+	field  = "getPost"
+}
+
+resource "aws_appsync_resolver" "get_comment" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "RootQuery"
+	field  = "getComment"
+}
+
+resource "aws_appsync_resolver" "query" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "Query"
+	field  = "getPost"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIResolverCoverageRule(),
+					Message: "\"RootQuery.getComment\" is not a field of the schema.",
+					Range: hcl.Range{
+						Filename: "resolver.tf",
+						Start:    hcl.Pos{Line: 11, Column: 11},
+						End:      hcl.Pos{Line: 11, Column: 23},
+					},
+				},
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIResolverCoverageRule(),
+					Message: "\"Query\" is not an object type of the schema.",
+					Range: hcl.Range{
+						Filename: "resolver.tf",
+						Start:    hcl.Pos{Line: 16, Column: 11},
+						End:      hcl.Pos{Line: 16, Column: 18},
+					},
+				},
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIResolverCoverageRule(),
+					Message: "\"RootMutation.createPost\" has no resolver.",
+					Range: hcl.Range{
+						Filename: "api.tf",
+						Start:    hcl.Pos{Line: 5, Column: 24},
+						End:      hcl.Pos{Line: 64, Column: 7},
+					},
+				},
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIResolverCoverageRule(),
+					Message: "\"RootMutation.deletePost\" has no resolver.",
+					Range: hcl.Range{
+						Filename: "api.tf",
+						Start:    hcl.Pos{Line: 5, Column: 24},
+						End:      hcl.Pos{Line: 64, Column: 7},
+					},
+				},
+				{
+					Rule:    NewAwsAppsyncGraphqlAPIResolverCoverageRule(),
+					Message: "\"RootQuery.listPosts\" has no resolver.",
+					Range: hcl.Range{
+						Filename: "api.tf",
+						Start:    hcl.Pos{Line: 5, Column: 24},
+						End:      hcl.Pos{Line: 64, Column: 7},
+					},
+				},
+			},
+		},
+		{
+			Name: "dynamic resolvers",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	for_each = toset(["getPost", "listPosts"])
+
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "RootQuery"
+	field  = each.value
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name:     "no resolvers",
+			Content:  ``,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncGraphqlAPIResolverCoverageRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{
+			"api.tf":      api,
+			"resolver.tf": tc.Content,
+		})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_AwsAppsyncGraphqlAPIResolverCoverage_unparsedSchema(t *testing.T) {
+	content := `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "AWS_IAM"
+	schema              = "type Query { getPost(id: ID!) Post }"
+}
+
+resource "aws_appsync_resolver" "this" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "Query"
+	field  = "listPosts"
+}
+`
+
+	rule := NewAwsAppsyncGraphqlAPIResolverCoverageRule()
+	runner := helper.TestRunner(t, map[string]string{"resource.tf": content})
+
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	// Schemas that cannot be parsed are skipped
+	helper.AssertIssues(t, helper.Issues{}, runner.Issues)
+}
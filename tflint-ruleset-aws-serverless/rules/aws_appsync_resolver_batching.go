@@ -0,0 +1,167 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAppsyncResolverBatching checks if Lambda resolvers on list element types batch invocations
+type AwsAppsyncResolverBatchingRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	apiType            string
+	dataSourceType     string
+	apiIDAttrName      string
+	typeAttrName       string
+	dataSourceAttrName string
+	batchSizeAttrName  string
+	lambdaType         string
+}
+
+// NewAwsAppsyncResolverBatchingRule returns new rule with default attributes
+func NewAwsAppsyncResolverBatchingRule() *AwsAppsyncResolverBatchingRule {
+	return &AwsAppsyncResolverBatchingRule{
+		resourceType:       "aws_appsync_resolver",
+		apiType:            "aws_appsync_graphql_api",
+		dataSourceType:     "aws_appsync_datasource",
+		apiIDAttrName:      "api_id",
+		typeAttrName:       "type",
+		dataSourceAttrName: "data_source",
+		batchSizeAttrName:  "max_batch_size",
+		lambdaType:         "AWS_LAMBDA",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncResolverBatchingRule) Name() string {
+	return "aws_appsync_resolver_batching"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncResolverBatchingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncResolverBatchingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncResolverBatchingRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/resolvers/"
+}
+
+// Check checks if Lambda resolvers on list element types batch invocations
+func (r *AwsAppsyncResolverBatchingRule) Check(runner tflint.Runner) error {
+	// Gather Lambda data sources
+	lambdaDataSources := make(map[string]bool)
+	resources, err := runner.GetResourceContent(r.dataSourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.typeAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.typeAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var dataSourceType string
+		if err := runner.EvaluateExpr(attr.Expr, &dataSourceType, nil); err != nil {
+			return err
+		}
+		lambdaDataSources[resource.Labels[1]] = dataSourceType == r.lambdaType
+	}
+
+	schemas, err := appsyncAPISchemas(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err = runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.apiIDAttrName},
+			{Name: r.typeAttrName},
+			{Name: r.dataSourceAttrName},
+			{Name: r.batchSizeAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		dataSourceAttr, ok := resource.Body.Attributes[r.dataSourceAttrName]
+		if !ok {
+			continue
+		}
+		dataSourceName, ok := resourceReference(dataSourceAttr.Expr, r.dataSourceType)
+		if !ok || !lambdaDataSources[dataSourceName] {
+			continue
+		}
+
+		apiIDAttr, ok := resource.Body.Attributes[r.apiIDAttrName]
+		if !ok {
+			continue
+		}
+		apiName, ok := resourceReference(apiIDAttr.Expr, r.apiType)
+		if !ok {
+			continue
+		}
+		api, ok := schemas[apiName]
+		if !ok || api.schema == nil {
+			continue
+		}
+
+		typeAttr, ok := resource.Body.Attributes[r.typeAttrName]
+		if !ok || !isStaticExpr(typeAttr.Expr) {
+			continue
+		}
+
+		var typeName string
+		if err := runner.EvaluateExpr(typeAttr.Expr, &typeName, nil); err != nil {
+			return err
+		}
+
+		// Resolvers on types returned in lists are invoked once per item
+		if !api.schema.listElementTypes()[typeName] {
+			continue
+		}
+
+		attr, ok := resource.Body.Attributes[r.batchSizeAttrName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.batchSizeAttrName),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		if !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var batchSize int
+		if err := runner.EvaluateExpr(attr.Expr, &batchSize, nil); err != nil {
+			return err
+		}
+
+		if batchSize <= 1 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be greater than 1.", r.batchSizeAttrName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
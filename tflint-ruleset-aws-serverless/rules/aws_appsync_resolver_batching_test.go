@@ -0,0 +1,166 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncResolverBatching(t *testing.T) {
+	api := `
+resource "aws_appsync_graphql_api" "this" {
+	name                = "my-api"
+	authentication_type = "AWS_IAM"
+	schema              = <<SCHEMA
+# Blog schema
+type Query {
+	"""
+	List all posts
+	"""
+	listPosts(limit: Int = 10, nextToken: String): [Post!]!
+	getPost(id: ID!): Post
+}
+
+type Post @aws_iam {
+	id: ID!
+	title: String
+	author: Author
+}
+
+type Author {
+	id: ID!
+	name: String
+}
+SCHEMA
+}
+
+resource "aws_appsync_datasource" "lambda" {
+	api_id = aws_appsync_graphql_api.this.id
+	name   = "lambda"
+	type   = "AWS_LAMBDA"
+}
+
+resource "aws_appsync_datasource" "table" {
+	api_id = aws_appsync_graphql_api.this.id
+	name   = "table"
+	type   = "AMAZON_DYNAMODB"
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "lambda resolver on list element type",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id      = aws_appsync_graphql_api.this.id
+	type        = "Post"
+	field       = "author"
+	data_source = aws_appsync_datasource.lambda.name
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncResolverBatchingRule(),
+					Message: "\"max_batch_size\" is not present.",
+					Range: hcl.Range{
+						Filename: "resolver.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "batching disabled",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id         = aws_appsync_graphql_api.this.id
+	type           = "Post"
+	field          = "author"
+	data_source    = aws_appsync_datasource.lambda.name
+	max_batch_size = 0
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncResolverBatchingRule(),
+					Message: "\"max_batch_size\" should be greater than 1.",
+					Range: hcl.Range{
+						Filename: "resolver.tf",
+						Start:    hcl.Pos{Line: 7, Column: 19},
+						End:      hcl.Pos{Line: 7, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "batching enabled",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id         = aws_appsync_graphql_api.this.id
+	type           = "Post"
+	field          = "author"
+	data_source    = aws_appsync_datasource.lambda.name
+	max_batch_size = 10
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "nested type not returned in a list",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id      = aws_appsync_graphql_api.this.id
+	type        = "Author"
+	field       = "name"
+	data_source = aws_appsync_datasource.lambda.name
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "root type",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id      = aws_appsync_graphql_api.this.id
+	type        = "Query"
+	field       = "getPost"
+	data_source = aws_appsync_datasource.lambda.name
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "dynamodb data source",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id      = aws_appsync_graphql_api.this.id
+	type        = "Post"
+	field       = "author"
+	data_source = aws_appsync_datasource.table.name
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncResolverBatchingRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{
+			"api.tf":      api,
+			"resolver.tf": tc.Content,
+		})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
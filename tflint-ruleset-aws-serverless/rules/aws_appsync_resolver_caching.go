@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAppsyncResolverCaching checks if resolvers of APIs with per-resolver caching have a caching configuration
+type AwsAppsyncResolverCachingRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	apiType            string
+	cacheType          string
+	apiIDAttrName      string
+	behaviorAttrName   string
+	blockName          string
+	perResolverCaching string
+}
+
+// NewAwsAppsyncResolverCachingRule returns new rule with default attributes
+func NewAwsAppsyncResolverCachingRule() *AwsAppsyncResolverCachingRule {
+	return &AwsAppsyncResolverCachingRule{
+		resourceType:       "aws_appsync_resolver",
+		apiType:            "aws_appsync_graphql_api",
+		cacheType:          "aws_appsync_api_cache",
+		apiIDAttrName:      "api_id",
+		behaviorAttrName:   "api_caching_behavior",
+		blockName:          "caching_config",
+		perResolverCaching: "PER_RESOLVER_CACHING",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncResolverCachingRule) Name() string {
+	return "aws_appsync_resolver_caching"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncResolverCachingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncResolverCachingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncResolverCachingRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/resolvers/"
+}
+
+// Check checks if resolvers of APIs with per-resolver caching have a caching configuration
+func (r *AwsAppsyncResolverCachingRule) Check(runner tflint.Runner) error {
+	// Gather APIs with per-resolver caching
+	cachedAPIs := make(map[string]bool)
+	resources, err := runner.GetResourceContent(r.cacheType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.apiIDAttrName},
+			{Name: r.behaviorAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		apiIDAttr, ok := resource.Body.Attributes[r.apiIDAttrName]
+		if !ok {
+			continue
+		}
+		apiName, ok := resourceReference(apiIDAttr.Expr, r.apiType)
+		if !ok {
+			continue
+		}
+
+		attr, ok := resource.Body.Attributes[r.behaviorAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var behavior string
+		if err := runner.EvaluateExpr(attr.Expr, &behavior, nil); err != nil {
+			return err
+		}
+		if behavior == r.perResolverCaching {
+			cachedAPIs[apiName] = true
+		}
+	}
+
+	resources, err = runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.apiIDAttrName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: &hclext.BodySchema{},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		apiIDAttr, ok := resource.Body.Attributes[r.apiIDAttrName]
+		if !ok {
+			continue
+		}
+		apiName, ok := resourceReference(apiIDAttr.Expr, r.apiType)
+		if !ok || !cachedAPIs[apiName] {
+			continue
+		}
+
+		if len(resource.Body.Blocks) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.blockName),
+				resource.DefRange,
+			)
+		}
+	}
+
+	return nil
+}
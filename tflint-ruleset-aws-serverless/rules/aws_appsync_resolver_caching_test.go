@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncResolverCaching(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "per-resolver caching without caching_config",
+			Content: `
+resource "aws_appsync_api_cache" "this" {
+	api_id               = aws_appsync_graphql_api.this.id
+	api_caching_behavior = "PER_RESOLVER_CACHING"
+	type                 = "SMALL"
+	ttl                  = 300
+}
+
+resource "aws_appsync_resolver" "this" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "Query"
+	field  = "getPost"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncResolverCachingRule(),
+					Message: "\"caching_config\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 1},
+						End:      hcl.Pos{Line: 9, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "per-resolver caching with caching_config",
+			Content: `
+resource "aws_appsync_api_cache" "this" {
+	api_id               = aws_appsync_graphql_api.this.id
+	api_caching_behavior = "PER_RESOLVER_CACHING"
+	type                 = "SMALL"
+	ttl                  = 300
+}
+
+resource "aws_appsync_resolver" "this" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "Query"
+	field  = "getPost"
+
+	caching_config {
+		caching_keys = ["$context.arguments.id"]
+		ttl          = 60
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "full request caching",
+			Content: `
+resource "aws_appsync_api_cache" "this" {
+	api_id               = aws_appsync_graphql_api.this.id
+	api_caching_behavior = "FULL_REQUEST_CACHING"
+	type                 = "SMALL"
+	ttl                  = 300
+}
+
+resource "aws_appsync_resolver" "this" {
+	api_id = aws_appsync_graphql_api.this.id
+	type   = "Query"
+	field  = "getPost"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "resolver of another api",
+			Content: `
+resource "aws_appsync_api_cache" "this" {
+	api_id               = aws_appsync_graphql_api.this.id
+	api_caching_behavior = "PER_RESOLVER_CACHING"
+	type                 = "SMALL"
+	ttl                  = 300
+}
+
+resource "aws_appsync_resolver" "this" {
+	api_id = aws_appsync_graphql_api.other.id
+	type   = "Query"
+	field  = "getPost"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncResolverCachingRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsAppsyncResolverRuntime checks if resolvers and functions use the APPSYNC_JS runtime
+type AwsAppsyncResolverRuntimeRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+	blockName     string
+	nameAttrName  string
+	codeAttrName  string
+	// templateAttrNames are the attributes of VTL mapping templates
+	templateAttrNames []string
+	jsRuntime         string
+}
+
+// NewAwsAppsyncResolverRuntimeRule returns new rule with default attributes
+func NewAwsAppsyncResolverRuntimeRule() *AwsAppsyncResolverRuntimeRule {
+	return &AwsAppsyncResolverRuntimeRule{
+		resourceTypes: []string{
+			"aws_appsync_resolver",
+			"aws_appsync_function",
+		},
+		blockName:    "runtime",
+		nameAttrName: "name",
+		codeAttrName: "code",
+		templateAttrNames: []string{
+			"request_template",
+			"response_template",
+		},
+		jsRuntime: "APPSYNC_JS",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAppsyncResolverRuntimeRule) Name() string {
+	return "aws_appsync_resolver_runtime"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAppsyncResolverRuntimeRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAppsyncResolverRuntimeRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAppsyncResolverRuntimeRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/appsync/resolvers/"
+}
+
+// Check checks if resolvers and functions use the APPSYNC_JS runtime
+func (r *AwsAppsyncResolverRuntimeRule) Check(runner tflint.Runner) error {
+	for _, resourceType := range r.resourceTypes {
+		attributes := []hclext.AttributeSchema{{Name: r.codeAttrName}}
+		for _, attrName := range r.templateAttrNames {
+			attributes = append(attributes, hclext.AttributeSchema{Name: attrName})
+		}
+
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: attributes,
+			Blocks: []hclext.BlockSchema{
+				{
+					Type: r.blockName,
+					Body: &hclext.BodySchema{
+						Attributes: []hclext.AttributeSchema{
+							{Name: r.nameAttrName},
+						},
+					},
+				},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			// Without a runtime, resolvers and functions use VTL mapping
+			// templates. Direct Lambda resolvers have neither templates nor code.
+			if len(resource.Body.Blocks) == 0 {
+				if len(resource.Body.Attributes) == 0 {
+					continue
+				}
+
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present, VTL mapping templates are used instead of %s.", r.blockName, r.jsRuntime),
+					resource.DefRange,
+				)
+				continue
+			}
+
+			attr, ok := resource.Body.Blocks[0].Body.Attributes[r.nameAttrName]
+			if !ok || !isStaticExpr(attr.Expr) {
+				continue
+			}
+
+			var runtime string
+			if err := runner.EvaluateExpr(attr.Expr, &runtime, nil); err != nil {
+				return err
+			}
+
+			if runtime != r.jsRuntime {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should be %s.", r.nameAttrName, r.jsRuntime),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
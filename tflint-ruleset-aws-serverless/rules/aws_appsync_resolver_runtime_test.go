@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAppsyncResolverRuntime(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "vtl resolver",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id            = aws_appsync_graphql_api.this.id
+	type              = "Query"
+	field             = "getPost"
+	data_source       = aws_appsync_datasource.this.name
+	request_template  = file("request.vtl")
+	response_template = file("response.vtl")
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncResolverRuntimeRule(),
+					Message: "\"runtime\" is not present, VTL mapping templates are used instead of APPSYNC_JS.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "function with other runtime",
+			Content: `
+resource "aws_appsync_function" "this" {
+	api_id      = aws_appsync_graphql_api.this.id
+	name        = "getPost"
+	data_source = aws_appsync_datasource.this.name
+	code        = file("function.js")
+
+	runtime {
+		name            = "OTHER"
+		runtime_version = "1.0.0"
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAppsyncResolverRuntimeRule(),
+					Message: "\"name\" should be APPSYNC_JS.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 21},
+						End:      hcl.Pos{Line: 9, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "direct lambda resolver",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id      = aws_appsync_graphql_api.this.id
+	type        = "Query"
+	field       = "getPost"
+	data_source = aws_appsync_datasource.lambda.name
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "js resolver",
+			Content: `
+resource "aws_appsync_resolver" "this" {
+	api_id      = aws_appsync_graphql_api.this.id
+	type        = "Query"
+	field       = "getPost"
+	data_source = aws_appsync_datasource.this.name
+	code        = file("resolver.js")
+
+	runtime {
+		name            = "APPSYNC_JS"
+		runtime_version = "1.0.0"
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAppsyncResolverRuntimeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
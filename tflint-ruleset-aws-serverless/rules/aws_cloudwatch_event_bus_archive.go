@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsCloudwatchEventBusArchive checks if custom event buses have an archive to replay events
+type AwsCloudwatchEventBusArchiveRule struct {
+	tflint.DefaultRule
+	resourceType        string
+	archiveResourceType string
+	nameAttrName        string
+	sourceAttrName      string
+	partnerAttrName     string
+	busArnPrefix        string
+}
+
+// NewAwsCloudwatchEventBusArchiveRule returns new rule with default attributes
+func NewAwsCloudwatchEventBusArchiveRule() *AwsCloudwatchEventBusArchiveRule {
+	return &AwsCloudwatchEventBusArchiveRule{
+		resourceType:        "aws_cloudwatch_event_bus",
+		archiveResourceType: "aws_cloudwatch_event_archive",
+		nameAttrName:        "name",
+		sourceAttrName:      "event_source_arn",
+		partnerAttrName:     "event_source_name",
+		busArnPrefix:        "event-bus/",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchEventBusArchiveRule) Name() string {
+	return "aws_cloudwatch_event_bus_archive"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchEventBusArchiveRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchEventBusArchiveRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchEventBusArchiveRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/event_bus_archive/"
+}
+
+// Check checks if custom event buses have an archive to replay events
+func (r *AwsCloudwatchEventBusArchiveRule) Check(runner tflint.Runner) error {
+	// Gather archived event buses, by resource name or by bus name for static ARNs
+	archivedResources := make(map[string]bool)
+	archivedNames := make(map[string]bool)
+
+	archives, err := runner.GetResourceContent(r.archiveResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.sourceAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, archive := range archives.Blocks {
+		attr, ok := archive.Body.Attributes[r.sourceAttrName]
+		if !ok {
+			continue
+		}
+
+		if busName, ok := resourceReference(attr.Expr, r.resourceType); ok {
+			archivedResources[busName] = true
+			continue
+		}
+
+		// Archives of unknown event buses could match any of them
+		if !isStaticExpr(attr.Expr) {
+			return nil
+		}
+
+		var arn string
+		if err := runner.EvaluateExpr(attr.Expr, &arn, nil); err != nil {
+			return err
+		}
+		if i := strings.LastIndex(arn, r.busArnPrefix); i >= 0 {
+			archivedNames[arn[i+len(r.busArnPrefix):]] = true
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.nameAttrName},
+			{Name: r.partnerAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if archivedResources[resource.Labels[1]] {
+			continue
+		}
+
+		// Partner event buses receive events from SaaS partners
+		if _, ok := resource.Body.Attributes[r.partnerAttrName]; ok {
+			continue
+		}
+
+		if attr, ok := resource.Body.Attributes[r.nameAttrName]; ok {
+			if !isStaticExpr(attr.Expr) {
+				continue
+			}
+
+			var name string
+			if err := runner.EvaluateExpr(attr.Expr, &name, nil); err != nil {
+				return err
+			}
+			if archivedNames[name] {
+				continue
+			}
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" has no \"%s\" to replay events.", r.resourceType, r.archiveResourceType),
+			resource.DefRange,
+		)
+	}
+
+	return nil
+}
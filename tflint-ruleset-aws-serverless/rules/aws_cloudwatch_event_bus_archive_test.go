@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchEventBusArchive(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "bus without archive",
+			Content: `
+resource "aws_cloudwatch_event_bus" "this" {
+  name = "orders"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventBusArchiveRule(),
+					Message: "\"aws_cloudwatch_event_bus\" has no \"aws_cloudwatch_event_archive\" to replay events.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "archive of another bus",
+			Content: `
+resource "aws_cloudwatch_event_bus" "this" {
+  name = "orders"
+}
+
+resource "aws_cloudwatch_event_archive" "this" {
+  name             = "payments"
+  event_source_arn = "arn:aws:events:us-east-1:111122223333:event-bus/payments"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventBusArchiveRule(),
+					Message: "\"aws_cloudwatch_event_bus\" has no \"aws_cloudwatch_event_archive\" to replay events.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "partner event bus",
+			Content: `
+resource "aws_cloudwatch_event_bus" "this" {
+  name              = "aws.partner/examplepartner.com/111122223333/my-source"
+  event_source_name = "aws.partner/examplepartner.com/111122223333/my-source"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "archive by reference",
+			Content: `
+resource "aws_cloudwatch_event_bus" "this" {
+  name = "orders"
+}
+
+resource "aws_cloudwatch_event_archive" "this" {
+  name             = "orders"
+  event_source_arn = aws_cloudwatch_event_bus.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "archive by arn",
+			Content: `
+resource "aws_cloudwatch_event_bus" "this" {
+  name = "orders"
+}
+
+resource "aws_cloudwatch_event_archive" "this" {
+  name             = "orders"
+  event_source_arn = "arn:aws:events:us-east-1:111122223333:event-bus/orders"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "archive of an unknown bus",
+			Content: `
+resource "aws_cloudwatch_event_bus" "this" {
+  name = "orders"
+}
+
+resource "aws_cloudwatch_event_archive" "this" {
+  name             = "orders"
+  event_source_arn = data.aws_cloudwatch_event_bus.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchEventBusArchiveRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,179 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsCloudwatchEventBusPolicyPrincipal checks if event buses shared with anyone are restricted to an AWS organization
+type AwsCloudwatchEventBusPolicyPrincipalRule struct {
+	tflint.DefaultRule
+	policyResourceType     string
+	permissionResourceType string
+	policyAttrName         string
+	principalAttrName      string
+	conditionBlockName     string
+	conditionKeyAttrName   string
+	conditionKey           string
+}
+
+// NewAwsCloudwatchEventBusPolicyPrincipalRule returns new rule with default attributes
+func NewAwsCloudwatchEventBusPolicyPrincipalRule() *AwsCloudwatchEventBusPolicyPrincipalRule {
+	return &AwsCloudwatchEventBusPolicyPrincipalRule{
+		policyResourceType:     "aws_cloudwatch_event_bus_policy",
+		permissionResourceType: "aws_cloudwatch_event_permission",
+		policyAttrName:         "policy",
+		principalAttrName:      "principal",
+		conditionBlockName:     "condition",
+		conditionKeyAttrName:   "key",
+		conditionKey:           "aws:PrincipalOrgID",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchEventBusPolicyPrincipalRule) Name() string {
+	return "aws_cloudwatch_event_bus_policy_principal"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchEventBusPolicyPrincipalRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchEventBusPolicyPrincipalRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchEventBusPolicyPrincipalRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/event_bus_policy/"
+}
+
+// checkPolicy checks if statements allowing anyone have an organization condition
+func (r *AwsCloudwatchEventBusPolicyPrincipalRule) checkPolicy(runner tflint.Runner, attr *hclext.Attribute) error {
+	// Policies built from other resources cannot be inspected
+	if !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var document string
+	if err := runner.EvaluateExpr(attr.Expr, &document, nil); err != nil {
+		return err
+	}
+
+	policy, err := parseIamPolicy(document)
+	if err != nil {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not a valid IAM policy document.", r.policyAttrName),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	for _, statement := range policy.Statement {
+		if statement.Effect == "Allow" && statement.Principal.isWildcard() && !statement.hasConditionKey(r.conditionKey) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" allows Principal \"*\" without a \"%s\" condition.", r.policyAttrName, r.conditionKey),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// checkPermission checks if a permission granted to anyone has an organization condition
+func (r *AwsCloudwatchEventBusPolicyPrincipalRule) checkPermission(runner tflint.Runner, resource *hclext.Block) error {
+	attr, ok := resource.Body.Attributes[r.principalAttrName]
+	if !ok || !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var principal string
+	if err := runner.EvaluateExpr(attr.Expr, &principal, nil); err != nil {
+		return err
+	}
+	if principal != "*" {
+		return nil
+	}
+
+	for _, condition := range resource.Body.Blocks.OfType(r.conditionBlockName) {
+		keyAttr, ok := condition.Body.Attributes[r.conditionKeyAttrName]
+		if !ok || !isStaticExpr(keyAttr.Expr) {
+			// Unknown condition keys are assumed to restrict access
+			return nil
+		}
+
+		var key string
+		if err := runner.EvaluateExpr(keyAttr.Expr, &key, nil); err != nil {
+			return err
+		}
+		if strings.EqualFold(key, r.conditionKey) {
+			return nil
+		}
+	}
+
+	runner.EmitIssue(
+		r,
+		fmt.Sprintf("\"%s\" is \"*\" without a \"%s\" condition.", r.principalAttrName, r.conditionKey),
+		attr.Expr.Range(),
+	)
+	return nil
+}
+
+// Check checks if event buses shared with anyone are restricted to an AWS organization
+func (r *AwsCloudwatchEventBusPolicyPrincipalRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.policyResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.policyAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.policyAttrName]
+		if !ok {
+			continue
+		}
+
+		if err := r.checkPolicy(runner, attr); err != nil {
+			return err
+		}
+	}
+
+	resources, err = runner.GetResourceContent(r.permissionResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.principalAttrName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.conditionBlockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.conditionKeyAttrName},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := r.checkPermission(runner, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchEventBusPolicyPrincipal(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "policy without organization condition",
+			Content: `
+resource "aws_cloudwatch_event_bus_policy" "this" {
+  event_bus_name = aws_cloudwatch_event_bus.this.name
+  policy         = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "events:PutEvents",
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventBusPolicyPrincipalRule(),
+					Message: "\"policy\" allows Principal \"*\" without a \"aws:PrincipalOrgID\" condition.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 20},
+						End:      hcl.Pos{Line: 14, Column: 7},
+					},
+				},
+			},
+		},
+		{
+			Name: "policy with organization condition",
+			Content: `
+resource "aws_cloudwatch_event_bus_policy" "this" {
+  event_bus_name = aws_cloudwatch_event_bus.this.name
+  policy         = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "*"},
+    "Action": "events:PutEvents",
+    "Resource": "*",
+    "Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-1234567890"}}
+  }]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "policy with boolean condition",
+			Content: `
+resource "aws_cloudwatch_event_bus_policy" "this" {
+  event_bus_name = aws_cloudwatch_event_bus.this.name
+  policy         = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "*"},
+      "Action": "events:PutEvents",
+      "Resource": "*",
+      "Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-1234567890"}}
+    },
+    {
+      "Effect": "Deny",
+      "Principal": {"AWS": "*"},
+      "Action": "events:PutEvents",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": false}, "NumericLessThan": {"aws:MultiFactorAuthAge": 3600}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "policy for an account",
+			Content: `
+resource "aws_cloudwatch_event_bus_policy" "this" {
+  event_bus_name = aws_cloudwatch_event_bus.this.name
+  policy         = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+    "Action": "events:PutEvents",
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "computed policy",
+			Content: `
+resource "aws_cloudwatch_event_bus_policy" "this" {
+  event_bus_name = aws_cloudwatch_event_bus.this.name
+  policy         = data.aws_iam_policy_document.this.json
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid policy",
+			Content: `
+resource "aws_cloudwatch_event_bus_policy" "this" {
+  event_bus_name = aws_cloudwatch_event_bus.this.name
+  policy         = "not a policy"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventBusPolicyPrincipalRule(),
+					Message: "\"policy\" is not a valid IAM policy document.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 20},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "permission without condition",
+			Content: `
+resource "aws_cloudwatch_event_permission" "this" {
+  principal    = "*"
+  statement_id = "OrganizationAccess"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventBusPolicyPrincipalRule(),
+					Message: "\"principal\" is \"*\" without a \"aws:PrincipalOrgID\" condition.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "permission with condition",
+			Content: `
+resource "aws_cloudwatch_event_permission" "this" {
+  principal    = "*"
+  statement_id = "OrganizationAccess"
+
+  condition {
+    key   = "aws:PrincipalOrgID"
+    type  = "StringEquals"
+    value = "o-1234567890"
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "permission for an account",
+			Content: `
+resource "aws_cloudwatch_event_permission" "this" {
+  principal    = "111122223333"
+  statement_id = "DevAccountAccess"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchEventBusPolicyPrincipalRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
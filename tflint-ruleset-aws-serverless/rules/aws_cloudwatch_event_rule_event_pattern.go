@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsCloudwatchEventRuleEventPattern checks if EventBridge rules have a valid and selective event pattern
+type AwsCloudwatchEventRuleEventPatternRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsCloudwatchEventRuleEventPatternRule returns new rule with default attributes
+func NewAwsCloudwatchEventRuleEventPatternRule() *AwsCloudwatchEventRuleEventPatternRule {
+	return &AwsCloudwatchEventRuleEventPatternRule{
+		resourceType:  "aws_cloudwatch_event_rule",
+		attributeName: "event_pattern",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchEventRuleEventPatternRule) Name() string {
+	return "aws_cloudwatch_event_rule_event_pattern"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchEventRuleEventPatternRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchEventRuleEventPatternRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchEventRuleEventPatternRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/event_pattern/"
+}
+
+// Check checks if EventBridge rules have a valid and selective event pattern
+func (r *AwsCloudwatchEventRuleEventPatternRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.attributeName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var document string
+		if err := runner.EvaluateExpr(attr.Expr, &document, nil); err != nil {
+			return err
+		}
+
+		pattern, err := parseEventPattern(document)
+		if err != nil {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not a valid event pattern: %s.", r.attributeName, err),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+
+		if pattern.matchesAll() {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" matches all events.", r.attributeName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
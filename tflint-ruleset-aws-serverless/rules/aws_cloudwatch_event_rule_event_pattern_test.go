@@ -0,0 +1,274 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchEventRuleEventPattern(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "valid pattern",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": [\"aws.ec2\"], \"detail-type\": [\"EC2 Instance State-change Notification\"], \"detail\": {\"state\": [\"running\", {\"anything-but\": [\"terminated\", \"stopped\"]}]}}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "content filters",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": [{\"prefix\": \"com.example.\"}], \"detail\": {\"size\": [{\"numeric\": [\">\", 0, \"<=\", 5]}], \"ip\": [{\"cidr\": \"10.0.0.0/24\"}], \"user\": [{\"exists\": true}], \"name\": [{\"suffix\": {\"equals-ignore-case\": \".PNG\"}}]}}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "or pattern",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": [\"my.app\"], \"$or\": [{\"detail\": {\"a\": [\"x\"]}}, {\"detail\": {\"b\": [\"y\"]}}]}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid json",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": [\"aws.ec2\"]"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: invalid JSON: unexpected EOF.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "empty pattern",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: the pattern should not be empty.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "scalar leaf",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": \"aws.ec2\"}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: \"source\" should be an array or an object.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "empty array",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"detail\": {\"state\": []}}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: \"detail.state\" should not be an empty array.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "nested array",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": [[\"aws.ec2\"]]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: \"source\" should not contain nested arrays.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown operator",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": [{\"startsWith\": \"aws.\"}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: \"source\" uses unknown operator \"startsWith\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 62},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid numeric",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"detail\": {\"size\": [{\"numeric\": [\"<>\", 0]}]}}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: \"detail.size\" has an invalid value for operator \"numeric\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 74},
+					},
+				},
+			},
+		},
+		{
+			Name: "multiple operators",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": [{\"prefix\": \"aws.\", \"suffix\": \".ec2\"}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: \"source\" content filters should have exactly one operator.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 80},
+					},
+				},
+			},
+		},
+		{
+			Name: "single or branch",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"$or\": [{\"source\": [\"aws.ec2\"]}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" is not a valid event pattern: \"$or\" should contain at least two patterns.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 60},
+					},
+				},
+			},
+		},
+		{
+			Name: "match all prefix",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"source\": [{\"prefix\": \"\"}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" matches all events.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 54},
+					},
+				},
+			},
+		},
+		{
+			Name: "match all or",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"$or\": [{\"source\": [\"aws.ec2\"]}, {\"account\": [{\"exists\": true}]}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleEventPatternRule(),
+					Message: "\"event_pattern\" matches all events.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 97},
+					},
+				},
+			},
+		},
+		{
+			Name: "empty prefix on detail",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	event_pattern = "{\"detail\": {\"state\": [{\"prefix\": \"\"}]}}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchEventRuleEventPatternRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsCloudwatchEventRuleScheduleExpression checks if EventBridge rules have a valid schedule expression
+type AwsCloudwatchEventRuleScheduleExpressionRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsCloudwatchEventRuleScheduleExpressionRule returns new rule with default attributes
+func NewAwsCloudwatchEventRuleScheduleExpressionRule() *AwsCloudwatchEventRuleScheduleExpressionRule {
+	return &AwsCloudwatchEventRuleScheduleExpressionRule{
+		resourceType:  "aws_cloudwatch_event_rule",
+		attributeName: "schedule_expression",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchEventRuleScheduleExpressionRule) Name() string {
+	return "aws_cloudwatch_event_rule_schedule_expression"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchEventRuleScheduleExpressionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchEventRuleScheduleExpressionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchEventRuleScheduleExpressionRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/schedule_expression/"
+}
+
+// Check checks if EventBridge rules have a valid schedule expression
+func (r *AwsCloudwatchEventRuleScheduleExpressionRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.attributeName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var expression string
+		if err := runner.EvaluateExpr(attr.Expr, &expression, nil); err != nil {
+			return err
+		}
+
+		if err := validateScheduleExpression(expression); err != nil {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not valid: %s.", r.attributeName, err),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
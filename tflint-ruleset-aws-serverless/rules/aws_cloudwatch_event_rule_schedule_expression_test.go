@@ -0,0 +1,198 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchEventRuleScheduleExpression(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "rate",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "rate(5 minutes)"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "rate singular",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "rate(1 hour)"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "cron",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "cron(0 12 * * ? *)"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "cron day of week",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "cron(0/15 8-17 ? * MON-FRI *)"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown expression",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "every 5 minutes"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleScheduleExpressionRule(),
+					Message: "\"schedule_expression\" is not valid: should be a rate() or cron() expression.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "rate plural for 1",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "rate(1 minutes)"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleScheduleExpressionRule(),
+					Message: "\"schedule_expression\" is not valid: rate() unit should be singular for a value of 1.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "rate singular for 5",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "rate(5 minute)"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleScheduleExpressionRule(),
+					Message: "\"schedule_expression\" is not valid: rate() unit should be plural for a value greater than 1.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "rate invalid unit",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "rate(5 seconds)"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleScheduleExpressionRule(),
+					Message: "\"schedule_expression\" is not valid: rate() unit should be minute(s), hour(s) or day(s).",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "rate invalid value",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "rate(0 minutes)"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleScheduleExpressionRule(),
+					Message: "\"schedule_expression\" is not valid: rate() value should be a positive integer.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "cron five fields",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "cron(0 12 * * ?)"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleScheduleExpressionRule(),
+					Message: "\"schedule_expression\" is not valid: cron() should have 6 fields, got 5.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "cron both days",
+			Content: `
+resource "aws_cloudwatch_event_rule" "this" {
+	schedule_expression = "cron(0 12 * * MON *)"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleScheduleExpressionRule(),
+					Message: "\"schedule_expression\" is not valid: cron() should use \"?\" in exactly one of the day-of-month and day-of-week fields.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 46},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsCloudwatchEventRuleScheduleExpressionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -10,17 +11,25 @@ import (
 // AwsCloudwatchEventTargetNoDlq checks if there is a DLQ configured on EventBridge targets
 type AwsCloudwatchEventTargetNoDlqRule struct {
 	tflint.DefaultRule
-	resourceType  string
-	blockName     string
-	attributeName string
+	resourceType      string
+	blockName         string
+	attributeName     string
+	retryBlockName    string
+	eventAgeAttrName  string
+	retryAttemptsAttr string
+	servicePrincipal  string
 }
 
 // NewAwsCloudwatchEventTargetNoDlqRule returns new rule with default attributes
 func NewAwsCloudwatchEventTargetNoDlqRule() *AwsCloudwatchEventTargetNoDlqRule {
 	return &AwsCloudwatchEventTargetNoDlqRule{
-		resourceType:  "aws_cloudwatch_event_target",
-		blockName:     "dead_letter_config",
-		attributeName: "arn",
+		resourceType:      "aws_cloudwatch_event_target",
+		blockName:         "dead_letter_config",
+		attributeName:     "arn",
+		retryBlockName:    "retry_policy",
+		eventAgeAttrName:  "maximum_event_age_in_seconds",
+		retryAttemptsAttr: "maximum_retry_attempts",
+		servicePrincipal:  "events.amazonaws.com",
 	}
 }
 
@@ -44,8 +53,107 @@ func (r *AwsCloudwatchEventTargetNoDlqRule) Link() string {
 	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/rule_without_dlq/"
 }
 
+// checkDeadLetterArn checks if the DLQ is a standard SQS queue that EventBridge can send messages to
+func (r *AwsCloudwatchEventTargetNoDlqRule) checkDeadLetterArn(runner tflint.Runner, attr *hclext.Attribute, queues map[string]*awsSqsQueue) error {
+	if queueName, ok := resourceReference(attr.Expr, sqsQueueType); ok {
+		queue, ok := queues[queueName]
+		if !ok {
+			return nil
+		}
+
+		if queue.fifo {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should not be a FIFO queue.", r.attributeName),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+
+		allowed, err := queue.allowsServiceSend(runner, r.servicePrincipal)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" queue policy does not allow %s to send messages.", r.attributeName, r.servicePrincipal),
+				attr.Expr.Range(),
+			)
+		}
+		return nil
+	}
+
+	if referencesOtherResource(attr.Expr, sqsQueueType) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should reference an %s.", r.attributeName, sqsQueueType),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	if !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var arn string
+	if err := runner.EvaluateExpr(attr.Expr, &arn, nil); err != nil {
+		return err
+	}
+
+	if !isSqsQueueArn(arn) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be the ARN of an SQS queue.", r.attributeName),
+			attr.Expr.Range(),
+		)
+	} else if strings.HasSuffix(arn, sqsFifoSuffix) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should not be a FIFO queue.", r.attributeName),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
+
+// checkRetryPolicy checks if the retry policy is bounded
+func (r *AwsCloudwatchEventTargetNoDlqRule) checkRetryPolicy(runner tflint.Runner, resource *hclext.Block, config *awsEventBridgeRetryConfig) error {
+	blocks := resource.Body.Blocks.OfType(r.retryBlockName)
+	if len(blocks) == 0 {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.retryBlockName),
+			resource.DefRange,
+		)
+		return nil
+	}
+
+	return checkRetryPolicyLimits(runner, r, blocks[0], []eventBridgeRetryLimit{
+		{r.eventAgeAttrName, config.MaximumEventAgeInSeconds},
+		{r.retryAttemptsAttr, config.MaximumRetryAttempts},
+	})
+}
+
 // Check checks if there is a DLQ configured on EventBridge targets
 func (r *AwsCloudwatchEventTargetNoDlqRule) Check(runner tflint.Runner) error {
+	// The maximum values supported by EventBridge (24 hours and 185 attempts)
+	// would keep retrying failed events long after they could be useful
+	config := &awsEventBridgeRetryConfig{
+		MaximumEventAgeInSeconds: 3600,
+		MaximumRetryAttempts:     10,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Blocks: []hclext.BlockSchema{
 			{
@@ -56,6 +164,15 @@ func (r *AwsCloudwatchEventTargetNoDlqRule) Check(runner tflint.Runner) error {
 					},
 				},
 			},
+			{
+				Type: r.retryBlockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.eventAgeAttrName},
+						{Name: r.retryAttemptsAttr},
+					},
+				},
+			},
 		},
 	}, nil)
 	if err != nil {
@@ -63,6 +180,10 @@ func (r *AwsCloudwatchEventTargetNoDlqRule) Check(runner tflint.Runner) error {
 	}
 
 	for _, resource := range resources.Blocks {
+		if err := r.checkRetryPolicy(runner, resource, config); err != nil {
+			return err
+		}
+
 		// Check for block
 		blocks := resource.Body.Blocks.OfType(r.blockName)
 		if len(blocks) == 0 {
@@ -75,13 +196,18 @@ func (r *AwsCloudwatchEventTargetNoDlqRule) Check(runner tflint.Runner) error {
 		}
 
 		// Check for attribute
-		_, exists := blocks[0].Body.Attributes[r.attributeName]
+		attr, exists := blocks[0].Body.Attributes[r.attributeName]
 		if !exists {
 			runner.EmitIssue(
 				r,
 				fmt.Sprintf("\"%s\" is not present.", r.attributeName),
 				blocks[0].DefRange,
 			)
+			continue
+		}
+
+		if err := r.checkDeadLetterArn(runner, attr, queues); err != nil {
+			return err
 		}
 	}
 
@@ -11,12 +11,17 @@ func Test_AwsCloudwatchEventTargetNoDlq(t *testing.T) {
 	cases := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
 			Name: "missing dead_letter_config",
 			Content: `
 resource "aws_cloudwatch_event_target" "this" {
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
 }
 `,
 			Expected: helper.Issues{
@@ -36,6 +41,11 @@ resource "aws_cloudwatch_event_target" "this" {
 			Content: `
 resource "aws_cloudwatch_event_target" "this" {
   dead_letter_config {}
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
 }
 `,
 			Expected: helper.Issues{
@@ -55,18 +65,381 @@ resource "aws_cloudwatch_event_target" "this" {
 			Content: `
 resource "aws_cloudwatch_event_target" "this" {
   dead_letter_config {
-	arn = "my-sqs-queue-arn"
+	arn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing retry_policy",
+			Content: `
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"retry_policy\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "unbounded retry_policy",
+			Content: `
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 7200
+  }
+}
+`,
+			Config: `
+rule "aws_cloudwatch_event_target_no_dlq" {
+  enabled                      = true
+  maximum_event_age_in_seconds = 3600
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"maximum_event_age_in_seconds\" should not exceed 3600.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 33},
+						End:      hcl.Pos{Line: 8, Column: 37},
+					},
+				},
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"maximum_retry_attempts\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "retry_policy over default limits",
+			Content: `
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 86400
+	maximum_retry_attempts       = 185
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"maximum_event_age_in_seconds\" should not exceed 3600.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 33},
+						End:      hcl.Pos{Line: 8, Column: 38},
+					},
+				},
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"maximum_retry_attempts\" should not exceed 10.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 33},
+						End:      hcl.Pos{Line: 9, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "fifo queue",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name       = "my-dlq.fifo"
+  fifo_queue = true
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = aws_sqs_queue.dlq.arn
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"arn\" should not be a FIFO queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 8},
+						End:      hcl.Pos{Line: 9, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "queue without policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = aws_sqs_queue.dlq.arn
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"arn\" queue policy does not allow events.amazonaws.com to send messages.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 8},
+						End:      hcl.Pos{Line: 8, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "queue policy for another service",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sqs_queue_policy" "dlq" {
+  queue_url = aws_sqs_queue.dlq.id
+  policy    = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "sns.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = aws_sqs_queue.dlq.arn
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"arn\" queue policy does not allow events.amazonaws.com to send messages.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 23, Column: 8},
+						End:      hcl.Pos{Line: 23, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "queue with policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sqs_queue_policy" "dlq" {
+  queue_url = aws_sqs_queue.dlq.id
+  policy    = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": ["events.amazonaws.com"]},
+    "Action": ["sqs:Send*"],
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = aws_sqs_queue.dlq.arn
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
   }
 }
 `,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "queue with boolean condition in policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sqs_queue_policy" "dlq" {
+  queue_url = aws_sqs_queue.dlq.id
+  policy    = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": "sqs:SendMessage",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sqs:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": false}}
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = aws_sqs_queue.dlq.arn
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "queue with computed policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name   = "my-dlq"
+  policy = data.aws_iam_policy_document.dlq.json
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = aws_sqs_queue.dlq.arn
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "not a queue",
+			Content: `
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = aws_sns_topic.this.arn
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"arn\" should reference an aws_sqs_queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 8},
+						End:      hcl.Pos{Line: 4, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "not a queue arn",
+			Content: `
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+	arn = "arn:aws:sns:us-east-1:111122223333:my-topic"
+  }
+
+  retry_policy {
+	maximum_event_age_in_seconds = 3600
+	maximum_retry_attempts       = 10
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetNoDlqRule(),
+					Message: "\"arn\" should be the ARN of an SQS queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 8},
+						End:      hcl.Pos{Line: 4, Column: 53},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAwsCloudwatchEventTargetNoDlqRule()
 
 	for _, tc := range cases {
-		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
 
 		if err := rule.Check(runner); err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsPipesPipeLogging checks if EventBridge Pipes have logging enabled
+type AwsPipesPipeLoggingRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	blockName         string
+	levelAttrName     string
+	destinationBlocks []string
+	disabledLevel     string
+}
+
+// NewAwsPipesPipeLoggingRule returns new rule with default attributes
+func NewAwsPipesPipeLoggingRule() *AwsPipesPipeLoggingRule {
+	return &AwsPipesPipeLoggingRule{
+		resourceType:  "aws_pipes_pipe",
+		blockName:     "log_configuration",
+		levelAttrName: "level",
+		destinationBlocks: []string{
+			"cloudwatch_logs_log_destination",
+			"firehose_log_destination",
+			"s3_log_destination",
+		},
+		disabledLevel: "OFF",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsPipesPipeLoggingRule) Name() string {
+	return "aws_pipes_pipe_logging"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsPipesPipeLoggingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsPipesPipeLoggingRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsPipesPipeLoggingRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/pipes/"
+}
+
+// Check checks if EventBridge Pipes have logging enabled
+func (r *AwsPipesPipeLoggingRule) Check(runner tflint.Runner) error {
+	logSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.levelAttrName},
+		},
+	}
+	for _, blockName := range r.destinationBlocks {
+		logSchema.Blocks = append(logSchema.Blocks, hclext.BlockSchema{
+			Type: blockName,
+			Body: &hclext.BodySchema{},
+		})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: logSchema,
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		blocks := resource.Body.Blocks.OfType(r.blockName)
+		if len(blocks) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.blockName),
+				resource.DefRange,
+			)
+			continue
+		}
+		block := blocks[0]
+
+		if len(block.Body.Blocks) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" has no log destination.", r.blockName),
+				block.DefRange,
+			)
+		}
+
+		attr, ok := block.Body.Attributes[r.levelAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var level string
+		if err := runner.EvaluateExpr(attr.Expr, &level, nil); err != nil {
+			return err
+		}
+
+		if level == r.disabledLevel {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should not be set to %s.", r.levelAttrName, r.disabledLevel),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
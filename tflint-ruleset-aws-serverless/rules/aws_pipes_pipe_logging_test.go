@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsPipesPipeLogging(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing log_configuration",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = aws_sqs_queue.this.arn
+	target = aws_lambda_function.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPipesPipeLoggingRule(),
+					Message: "\"log_configuration\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "logging disabled without destination",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = aws_sqs_queue.this.arn
+	target = aws_lambda_function.this.arn
+
+	log_configuration {
+		level = "OFF"
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPipesPipeLoggingRule(),
+					Message: "\"log_configuration\" has no log destination.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 2},
+						End:      hcl.Pos{Line: 6, Column: 19},
+					},
+				},
+				{
+					Rule:    NewAwsPipesPipeLoggingRule(),
+					Message: "\"level\" should not be set to OFF.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 7, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "logging enabled",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = aws_sqs_queue.this.arn
+	target = aws_lambda_function.this.arn
+
+	log_configuration {
+		level = "ERROR"
+
+		cloudwatch_logs_log_destination {
+			log_group_arn = aws_cloudwatch_log_group.this.arn
+		}
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsPipesPipeLoggingRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,295 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// awsPipesPipeNoDlqConfig is the rule configuration for EventBridge Pipes retries
+type awsPipesPipeNoDlqConfig struct {
+	MaximumRetryAttempts int `hclext:"maximum_retry_attempts,optional"`
+}
+
+// AwsPipesPipeNoDlq checks if failed records of EventBridge Pipes sources are sent to a DLQ after bounded retries
+type AwsPipesPipeNoDlqRule struct {
+	tflint.DefaultRule
+	resourceType        string
+	sourceAttrName      string
+	sourceParamsBlock   string
+	blockName           string
+	attributeName       string
+	retryAttemptsAttr   string
+	streamParamsBlocks  map[string]string
+	streamResourceTypes map[string]string
+	sqsParamsBlock      string
+	infiniteRetries     int
+}
+
+// NewAwsPipesPipeNoDlqRule returns new rule with default attributes
+func NewAwsPipesPipeNoDlqRule() *AwsPipesPipeNoDlqRule {
+	return &AwsPipesPipeNoDlqRule{
+		resourceType:      "aws_pipes_pipe",
+		sourceAttrName:    "source",
+		sourceParamsBlock: "source_parameters",
+		blockName:         "dead_letter_config",
+		attributeName:     "arn",
+		retryAttemptsAttr: "maximum_retry_attempts",
+		// Source services using stream parameters, by ARN service name
+		streamParamsBlocks: map[string]string{
+			"kinesis":  "kinesis_stream_parameters",
+			"dynamodb": "dynamodb_stream_parameters",
+		},
+		streamResourceTypes: map[string]string{
+			"kinesis":  "aws_kinesis_stream",
+			"dynamodb": "aws_dynamodb_table",
+		},
+		sqsParamsBlock:  "sqs_queue_parameters",
+		infiniteRetries: -1,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsPipesPipeNoDlqRule) Name() string {
+	return "aws_pipes_pipe_no_dlq"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsPipesPipeNoDlqRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsPipesPipeNoDlqRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsPipesPipeNoDlqRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/pipes/"
+}
+
+// sourceService returns the service of the pipe source ("sqs", "kinesis",
+// "dynamodb", ...), or an empty string if it is unknown
+func (r *AwsPipesPipeNoDlqRule) sourceService(runner tflint.Runner, resource *hclext.Block) (string, error) {
+	for _, params := range resource.Body.Blocks.OfType(r.sourceParamsBlock) {
+		if len(params.Body.Blocks.OfType(r.sqsParamsBlock)) > 0 {
+			return "sqs", nil
+		}
+		for service, blockName := range r.streamParamsBlocks {
+			if len(params.Body.Blocks.OfType(blockName)) > 0 {
+				return service, nil
+			}
+		}
+	}
+
+	attr, ok := resource.Body.Attributes[r.sourceAttrName]
+	if !ok {
+		return "", nil
+	}
+
+	if _, ok := resourceReference(attr.Expr, sqsQueueType); ok {
+		return "sqs", nil
+	}
+	for service, resourceType := range r.streamResourceTypes {
+		if _, ok := resourceReference(attr.Expr, resourceType); ok {
+			return service, nil
+		}
+	}
+
+	if !isStaticExpr(attr.Expr) {
+		return "", nil
+	}
+
+	var arn string
+	if err := runner.EvaluateExpr(attr.Expr, &arn, nil); err != nil {
+		return "", err
+	}
+	if parts := strings.Split(arn, ":"); len(parts) > 2 {
+		return parts[2], nil
+	}
+
+	return "", nil
+}
+
+// checkStreamSource checks if stream sources have a DLQ and bounded retries
+func (r *AwsPipesPipeNoDlqRule) checkStreamSource(runner tflint.Runner, resource *hclext.Block, blockName string, config *awsPipesPipeNoDlqConfig) error {
+	var params *hclext.Block
+	for _, sourceParams := range resource.Body.Blocks.OfType(r.sourceParamsBlock) {
+		if blocks := sourceParams.Body.Blocks.OfType(blockName); len(blocks) > 0 {
+			params = blocks[0]
+		}
+	}
+	if params == nil {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", blockName),
+			resource.DefRange,
+		)
+		return nil
+	}
+
+	// Check dead-letter queue
+	if blocks := params.Body.Blocks.OfType(r.blockName); len(blocks) == 0 {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.blockName),
+			params.DefRange,
+		)
+	} else if _, ok := blocks[0].Body.Attributes[r.attributeName]; !ok {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.attributeName),
+			blocks[0].DefRange,
+		)
+	}
+
+	// Check retries, which are infinite by default
+	attr, ok := params.Body.Attributes[r.retryAttemptsAttr]
+	if !ok {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.retryAttemptsAttr),
+			params.DefRange,
+		)
+		return nil
+	}
+
+	if !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var retries int
+	if err := runner.EvaluateExpr(attr.Expr, &retries, nil); err != nil {
+		return err
+	}
+
+	if retries == r.infiniteRetries {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should not be %d (infinite).", r.retryAttemptsAttr, r.infiniteRetries),
+			attr.Expr.Range(),
+		)
+	} else if retries > config.MaximumRetryAttempts {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should not exceed %d.", r.retryAttemptsAttr, config.MaximumRetryAttempts),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
+
+// checkQueueSource checks if SQS sources have a redrive policy, as pipes do
+// not support dead-letter queues for SQS sources
+func (r *AwsPipesPipeNoDlqRule) checkQueueSource(runner tflint.Runner, resource *hclext.Block, queues map[string]*awsSqsQueue) {
+	attr, ok := resource.Body.Attributes[r.sourceAttrName]
+	if !ok {
+		return
+	}
+	queueName, ok := resourceReference(attr.Expr, sqsQueueType)
+	if !ok {
+		return
+	}
+	queue, ok := queues[queueName]
+	if !ok {
+		return
+	}
+
+	if len(queue.redrivePolicies) == 0 {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" queue has no redrive policy.", r.sourceAttrName),
+			attr.Expr.Range(),
+		)
+	}
+}
+
+// Check checks if failed records of EventBridge Pipes sources are sent to a DLQ after bounded retries
+func (r *AwsPipesPipeNoDlqRule) Check(runner tflint.Runner) error {
+	// The maximum value supported by EventBridge Pipes (10000) would block
+	// the shard for a long time, so the default limit is much lower
+	config := &awsPipesPipeNoDlqConfig{
+		MaximumRetryAttempts: 10,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
+	streamParamsSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.retryAttemptsAttr},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.attributeName},
+					},
+				},
+			},
+		},
+	}
+	sourceParamsSchema := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.sqsParamsBlock,
+				Body: &hclext.BodySchema{},
+			},
+		},
+	}
+	for _, blockName := range r.streamParamsBlocks {
+		sourceParamsSchema.Blocks = append(sourceParamsSchema.Blocks, hclext.BlockSchema{
+			Type: blockName,
+			Body: streamParamsSchema,
+		})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.sourceAttrName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.sourceParamsBlock,
+				Body: sourceParamsSchema,
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		service, err := r.sourceService(runner, resource)
+		if err != nil {
+			return err
+		}
+
+		if service == "sqs" {
+			r.checkQueueSource(runner, resource, queues)
+			continue
+		}
+
+		// Other sources, such as Amazon MQ or Kafka, do not support dead-letter queues
+		blockName, ok := r.streamParamsBlocks[service]
+		if !ok {
+			continue
+		}
+
+		if err := r.checkStreamSource(runner, resource, blockName, config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,295 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsPipesPipeNoDlq(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "kinesis source without dlq",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = aws_kinesis_stream.this.arn
+	target = aws_lambda_function.this.arn
+
+	source_parameters {
+		kinesis_stream_parameters {
+			starting_position = "LATEST"
+		}
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPipesPipeNoDlqRule(),
+					Message: "\"dead_letter_config\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 28},
+					},
+				},
+				{
+					Rule:    NewAwsPipesPipeNoDlqRule(),
+					Message: "\"maximum_retry_attempts\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "dynamodb source with infinite retries",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = aws_dynamodb_table.this.stream_arn
+	target = aws_lambda_function.this.arn
+
+	source_parameters {
+		dynamodb_stream_parameters {
+			starting_position      = "LATEST"
+			maximum_retry_attempts = -1
+
+			dead_letter_config {
+				arn = aws_sqs_queue.dlq.arn
+			}
+		}
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPipesPipeNoDlqRule(),
+					Message: "\"maximum_retry_attempts\" should not be -1 (infinite).",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 29},
+						End:      hcl.Pos{Line: 9, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "retries above the configured limit",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = "arn:aws:kinesis:us-east-1:111122223333:stream/my-stream"
+	target = aws_lambda_function.this.arn
+
+	source_parameters {
+		kinesis_stream_parameters {
+			starting_position      = "LATEST"
+			maximum_retry_attempts = 100
+
+			dead_letter_config {
+				arn = aws_sqs_queue.dlq.arn
+			}
+		}
+	}
+}
+`,
+			Config: `
+rule "aws_pipes_pipe_no_dlq" {
+	enabled                = true
+	maximum_retry_attempts = 50
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPipesPipeNoDlqRule(),
+					Message: "\"maximum_retry_attempts\" should not exceed 50.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 29},
+						End:      hcl.Pos{Line: 9, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "retries above the default limit",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = "arn:aws:kinesis:us-east-1:111122223333:stream/my-stream"
+	target = aws_lambda_function.this.arn
+
+	source_parameters {
+		kinesis_stream_parameters {
+			starting_position      = "LATEST"
+			maximum_retry_attempts = 20
+
+			dead_letter_config {
+				arn = aws_sqs_queue.dlq.arn
+			}
+		}
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPipesPipeNoDlqRule(),
+					Message: "\"maximum_retry_attempts\" should not exceed 10.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 29},
+						End:      hcl.Pos{Line: 9, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "stream source without parameters",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = "arn:aws:kinesis:us-east-1:111122223333:stream/my-stream"
+	target = aws_lambda_function.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPipesPipeNoDlqRule(),
+					Message: "\"kinesis_stream_parameters\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "sqs source without redrive policy",
+			Content: `
+resource "aws_sqs_queue" "source" {
+	name = "my-queue"
+}
+
+resource "aws_pipes_pipe" "this" {
+	source = aws_sqs_queue.source.arn
+	target = aws_lambda_function.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPipesPipeNoDlqRule(),
+					Message: "\"source\" queue has no redrive policy.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 7, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "sqs source with redrive policy",
+			Content: `
+resource "aws_sqs_queue" "source" {
+	name           = "my-queue"
+	redrive_policy = jsonencode({
+		deadLetterTargetArn = aws_sqs_queue.dlq.arn
+		maxReceiveCount     = 4
+	})
+}
+
+resource "aws_pipes_pipe" "this" {
+	source = aws_sqs_queue.source.arn
+	target = aws_lambda_function.this.arn
+
+	source_parameters {
+		sqs_queue_parameters {
+			batch_size = 10
+		}
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "sqs source with redrive policy resource",
+			Content: `
+resource "aws_sqs_queue" "source" {
+	name = "my-queue"
+}
+
+resource "aws_sqs_queue_redrive_policy" "source" {
+	queue_url      = aws_sqs_queue.source.id
+	redrive_policy = jsonencode({
+		deadLetterTargetArn = aws_sqs_queue.dlq.arn
+		maxReceiveCount     = 4
+	})
+}
+
+resource "aws_pipes_pipe" "this" {
+	source = aws_sqs_queue.source.arn
+	target = aws_lambda_function.this.arn
+
+	source_parameters {
+		sqs_queue_parameters {
+			batch_size = 10
+		}
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "kinesis source with dlq",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = aws_kinesis_stream.this.arn
+	target = aws_lambda_function.this.arn
+
+	source_parameters {
+		kinesis_stream_parameters {
+			starting_position      = "LATEST"
+			maximum_retry_attempts = 10
+
+			dead_letter_config {
+				arn = aws_sqs_queue.dlq.arn
+			}
+		}
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "mq source",
+			Content: `
+resource "aws_pipes_pipe" "this" {
+	source = aws_mq_broker.this.arn
+	target = aws_lambda_function.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsPipesPipeNoDlqRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
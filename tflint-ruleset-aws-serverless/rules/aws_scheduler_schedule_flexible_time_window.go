@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSchedulerScheduleFlexibleTimeWindow checks if EventBridge Scheduler flexible time windows are consistent
+type AwsSchedulerScheduleFlexibleTimeWindowRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	blockName      string
+	modeAttrName   string
+	windowAttrName string
+	offMode        string
+	flexibleMode   string
+	maxWindow      int
+}
+
+// NewAwsSchedulerScheduleFlexibleTimeWindowRule returns new rule with default attributes
+func NewAwsSchedulerScheduleFlexibleTimeWindowRule() *AwsSchedulerScheduleFlexibleTimeWindowRule {
+	return &AwsSchedulerScheduleFlexibleTimeWindowRule{
+		resourceType:   "aws_scheduler_schedule",
+		blockName:      "flexible_time_window",
+		modeAttrName:   "mode",
+		windowAttrName: "maximum_window_in_minutes",
+		offMode:        "OFF",
+		flexibleMode:   "FLEXIBLE",
+		maxWindow:      1440,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSchedulerScheduleFlexibleTimeWindowRule) Name() string {
+	return "aws_scheduler_schedule_flexible_time_window"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSchedulerScheduleFlexibleTimeWindowRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSchedulerScheduleFlexibleTimeWindowRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSchedulerScheduleFlexibleTimeWindowRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/scheduler/"
+}
+
+// Check checks if EventBridge Scheduler flexible time windows are consistent
+func (r *AwsSchedulerScheduleFlexibleTimeWindowRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.modeAttrName},
+						{Name: r.windowAttrName},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, block := range resource.Body.Blocks {
+			modeAttr, ok := block.Body.Attributes[r.modeAttrName]
+			if !ok || !isStaticExpr(modeAttr.Expr) {
+				continue
+			}
+
+			var mode string
+			if err := runner.EvaluateExpr(modeAttr.Expr, &mode, nil); err != nil {
+				return err
+			}
+
+			windowAttr, hasWindow := block.Body.Attributes[r.windowAttrName]
+
+			switch mode {
+			case r.offMode:
+				if hasWindow {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("\"%s\" should not be set when \"%s\" is %s.", r.windowAttrName, r.modeAttrName, r.offMode),
+						windowAttr.Expr.Range(),
+					)
+				}
+			case r.flexibleMode:
+				if !hasWindow {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("\"%s\" is required when \"%s\" is %s.", r.windowAttrName, r.modeAttrName, r.flexibleMode),
+						block.DefRange,
+					)
+					continue
+				}
+
+				if !isStaticExpr(windowAttr.Expr) {
+					continue
+				}
+
+				var window int
+				if err := runner.EvaluateExpr(windowAttr.Expr, &window, nil); err != nil {
+					return err
+				}
+
+				if window < 1 || window > r.maxWindow {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("\"%s\" should be between 1 and %d.", r.windowAttrName, r.maxWindow),
+						windowAttr.Expr.Range(),
+					)
+				}
+			}
+		}
+	}
+
+	return nil
+}
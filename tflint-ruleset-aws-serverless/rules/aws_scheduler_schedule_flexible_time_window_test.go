@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSchedulerScheduleFlexibleTimeWindow(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "window with mode OFF",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	flexible_time_window {
+		mode                      = "OFF"
+		maximum_window_in_minutes = 15
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSchedulerScheduleFlexibleTimeWindowRule(),
+					Message: "\"maximum_window_in_minutes\" should not be set when \"mode\" is OFF.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 31},
+						End:      hcl.Pos{Line: 5, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "flexible without window",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	flexible_time_window {
+		mode = "FLEXIBLE"
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSchedulerScheduleFlexibleTimeWindowRule(),
+					Message: "\"maximum_window_in_minutes\" is required when \"mode\" is FLEXIBLE.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 2},
+						End:      hcl.Pos{Line: 3, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "flexible window too large",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	flexible_time_window {
+		mode                      = "FLEXIBLE"
+		maximum_window_in_minutes = 2880
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSchedulerScheduleFlexibleTimeWindowRule(),
+					Message: "\"maximum_window_in_minutes\" should be between 1 and 1440.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 31},
+						End:      hcl.Pos{Line: 5, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "flexible",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	flexible_time_window {
+		mode                      = "FLEXIBLE"
+		maximum_window_in_minutes = 15
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "off",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	flexible_time_window {
+		mode = "OFF"
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSchedulerScheduleFlexibleTimeWindowRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
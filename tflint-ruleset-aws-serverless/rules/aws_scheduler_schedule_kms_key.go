@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSchedulerScheduleKmsKey checks if EventBridge Scheduler schedules are encrypted with a customer-managed key
+type AwsSchedulerScheduleKmsKeyRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsSchedulerScheduleKmsKeyRule returns new rule with default attributes
+func NewAwsSchedulerScheduleKmsKeyRule() *AwsSchedulerScheduleKmsKeyRule {
+	return &AwsSchedulerScheduleKmsKeyRule{
+		resourceType:  "aws_scheduler_schedule",
+		attributeName: "kms_key_arn",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSchedulerScheduleKmsKeyRule) Name() string {
+	return "aws_scheduler_schedule_kms_key"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSchedulerScheduleKmsKeyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsSchedulerScheduleKmsKeyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSchedulerScheduleKmsKeyRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/scheduler/"
+}
+
+// Check checks if EventBridge Scheduler schedules are encrypted with a customer-managed key
+func (r *AwsSchedulerScheduleKmsKeyRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if _, ok := resource.Body.Attributes[r.attributeName]; !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.attributeName),
+				resource.DefRange,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSchedulerScheduleKmsKey(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing kms_key_arn",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	name = "my-schedule"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSchedulerScheduleKmsKeyRule(),
+					Message: "\"kms_key_arn\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "kms_key_arn",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	name        = "my-schedule"
+	kms_key_arn = aws_kms_key.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSchedulerScheduleKmsKeyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSchedulerScheduleNoDlq checks if there is a DLQ and a bounded retry policy configured on EventBridge Scheduler targets
+type AwsSchedulerScheduleNoDlqRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	targetBlockName   string
+	blockName         string
+	attributeName     string
+	retryBlockName    string
+	eventAgeAttrName  string
+	retryAttemptsAttr string
+}
+
+// NewAwsSchedulerScheduleNoDlqRule returns new rule with default attributes
+func NewAwsSchedulerScheduleNoDlqRule() *AwsSchedulerScheduleNoDlqRule {
+	return &AwsSchedulerScheduleNoDlqRule{
+		resourceType:      "aws_scheduler_schedule",
+		targetBlockName:   "target",
+		blockName:         "dead_letter_config",
+		attributeName:     "arn",
+		retryBlockName:    "retry_policy",
+		eventAgeAttrName:  "maximum_event_age_in_seconds",
+		retryAttemptsAttr: "maximum_retry_attempts",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSchedulerScheduleNoDlqRule) Name() string {
+	return "aws_scheduler_schedule_no_dlq"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSchedulerScheduleNoDlqRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSchedulerScheduleNoDlqRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSchedulerScheduleNoDlqRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/scheduler/"
+}
+
+// checkDeadLetterArn checks if the DLQ is an SQS queue
+func (r *AwsSchedulerScheduleNoDlqRule) checkDeadLetterArn(runner tflint.Runner, attr *hclext.Attribute) error {
+	if referencesOtherResource(attr.Expr, sqsQueueType) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should reference an %s.", r.attributeName, sqsQueueType),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	if !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var arn string
+	if err := runner.EvaluateExpr(attr.Expr, &arn, nil); err != nil {
+		return err
+	}
+
+	if !isSqsQueueArn(arn) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be the ARN of an SQS queue.", r.attributeName),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
+
+// Check checks if there is a DLQ and a bounded retry policy configured on EventBridge Scheduler targets
+func (r *AwsSchedulerScheduleNoDlqRule) Check(runner tflint.Runner) error {
+	// The maximum values supported by EventBridge Scheduler (24 hours and 185
+	// attempts) would keep retrying failed invocations long after they could
+	// be useful
+	config := &awsEventBridgeRetryConfig{
+		MaximumEventAgeInSeconds: 3600,
+		MaximumRetryAttempts:     10,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.targetBlockName,
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: r.blockName,
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{
+									{Name: r.attributeName},
+								},
+							},
+						},
+						{
+							Type: r.retryBlockName,
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{
+									{Name: r.eventAgeAttrName},
+									{Name: r.retryAttemptsAttr},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, target := range resource.Body.Blocks {
+			// Check retry policy
+			retryBlocks := target.Body.Blocks.OfType(r.retryBlockName)
+			if len(retryBlocks) == 0 {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present.", r.retryBlockName),
+					target.DefRange,
+				)
+			} else if err := checkRetryPolicyLimits(runner, r, retryBlocks[0], []eventBridgeRetryLimit{
+				{r.eventAgeAttrName, config.MaximumEventAgeInSeconds},
+				{r.retryAttemptsAttr, config.MaximumRetryAttempts},
+			}); err != nil {
+				return err
+			}
+
+			// Check dead-letter queue
+			blocks := target.Body.Blocks.OfType(r.blockName)
+			if len(blocks) == 0 {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present.", r.blockName),
+					target.DefRange,
+				)
+				continue
+			}
+
+			attr, ok := blocks[0].Body.Attributes[r.attributeName]
+			if !ok {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present.", r.attributeName),
+					blocks[0].DefRange,
+				)
+				continue
+			}
+
+			if err := r.checkDeadLetterArn(runner, attr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
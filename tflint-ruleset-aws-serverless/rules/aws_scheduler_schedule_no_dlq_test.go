@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSchedulerScheduleNoDlq(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing dead_letter_config and retry_policy",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	name = "my-schedule"
+
+	target {
+		arn      = aws_lambda_function.this.arn
+		role_arn = aws_iam_role.this.arn
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSchedulerScheduleNoDlqRule(),
+					Message: "\"retry_policy\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 2},
+						End:      hcl.Pos{Line: 5, Column: 8},
+					},
+				},
+				{
+					Rule:    NewAwsSchedulerScheduleNoDlqRule(),
+					Message: "\"dead_letter_config\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 2},
+						End:      hcl.Pos{Line: 5, Column: 8},
+					},
+				},
+			},
+		},
+		{
+			Name: "unbounded retry_policy and invalid dlq",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	name = "my-schedule"
+
+	target {
+		arn      = aws_lambda_function.this.arn
+		role_arn = aws_iam_role.this.arn
+
+		dead_letter_config {
+			arn = aws_sns_topic.this.arn
+		}
+
+		retry_policy {
+			maximum_retry_attempts = 20
+		}
+	}
+}
+`,
+			Config: `
+rule "aws_scheduler_schedule_no_dlq" {
+	enabled                = true
+	maximum_retry_attempts = 5
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSchedulerScheduleNoDlqRule(),
+					Message: "\"maximum_event_age_in_seconds\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 13, Column: 3},
+						End:      hcl.Pos{Line: 13, Column: 15},
+					},
+				},
+				{
+					Rule:    NewAwsSchedulerScheduleNoDlqRule(),
+					Message: "\"maximum_retry_attempts\" should not exceed 5.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 29},
+						End:      hcl.Pos{Line: 14, Column: 31},
+					},
+				},
+				{
+					Rule:    NewAwsSchedulerScheduleNoDlqRule(),
+					Message: "\"arn\" should reference an aws_sqs_queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 10},
+						End:      hcl.Pos{Line: 10, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "retry_policy over default limits",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	name = "my-schedule"
+
+	target {
+		arn      = aws_lambda_function.this.arn
+		role_arn = aws_iam_role.this.arn
+
+		dead_letter_config {
+			arn = aws_sqs_queue.dlq.arn
+		}
+
+		retry_policy {
+			maximum_event_age_in_seconds = 86400
+			maximum_retry_attempts       = 185
+		}
+	}
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSchedulerScheduleNoDlqRule(),
+					Message: "\"maximum_event_age_in_seconds\" should not exceed 3600.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 35},
+						End:      hcl.Pos{Line: 14, Column: 40},
+					},
+				},
+				{
+					Rule:    NewAwsSchedulerScheduleNoDlqRule(),
+					Message: "\"maximum_retry_attempts\" should not exceed 10.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 35},
+						End:      hcl.Pos{Line: 15, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "correct",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	name = "my-schedule"
+
+	target {
+		arn      = aws_lambda_function.this.arn
+		role_arn = aws_iam_role.this.arn
+
+		dead_letter_config {
+			arn = aws_sqs_queue.dlq.arn
+		}
+
+		retry_policy {
+			maximum_event_age_in_seconds = 3600
+			maximum_retry_attempts       = 5
+		}
+	}
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSchedulerScheduleNoDlqRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
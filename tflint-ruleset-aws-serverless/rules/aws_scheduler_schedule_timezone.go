@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSchedulerScheduleTimezone checks if cron-based EventBridge Scheduler schedules have an explicit timezone
+type AwsSchedulerScheduleTimezoneRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	expressionAttrName string
+	timezoneAttrName   string
+	cronPrefix         string
+}
+
+// NewAwsSchedulerScheduleTimezoneRule returns new rule with default attributes
+func NewAwsSchedulerScheduleTimezoneRule() *AwsSchedulerScheduleTimezoneRule {
+	return &AwsSchedulerScheduleTimezoneRule{
+		resourceType:       "aws_scheduler_schedule",
+		expressionAttrName: "schedule_expression",
+		timezoneAttrName:   "schedule_expression_timezone",
+		cronPrefix:         "cron(",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSchedulerScheduleTimezoneRule) Name() string {
+	return "aws_scheduler_schedule_timezone"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSchedulerScheduleTimezoneRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSchedulerScheduleTimezoneRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSchedulerScheduleTimezoneRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/eventbridge/scheduler/"
+}
+
+// Check checks if cron-based EventBridge Scheduler schedules have an explicit timezone
+func (r *AwsSchedulerScheduleTimezoneRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.expressionAttrName},
+			{Name: r.timezoneAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if _, ok := resource.Body.Attributes[r.timezoneAttrName]; ok {
+			continue
+		}
+
+		attr, ok := resource.Body.Attributes[r.expressionAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var expression string
+		if err := runner.EvaluateExpr(attr.Expr, &expression, nil); err != nil {
+			return err
+		}
+
+		// rate() and at() expressions do not depend on the timezone
+		if strings.HasPrefix(expression, r.cronPrefix) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present for a cron() schedule.", r.timezoneAttrName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSchedulerScheduleTimezone(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "cron without timezone",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	schedule_expression = "cron(0 8 ? * MON-FRI *)"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSchedulerScheduleTimezoneRule(),
+					Message: "\"schedule_expression_timezone\" is not present for a cron() schedule.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "cron with timezone",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	schedule_expression          = "cron(0 8 ? * MON-FRI *)"
+	schedule_expression_timezone = "Europe/Paris"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "rate",
+			Content: `
+resource "aws_scheduler_schedule" "this" {
+	schedule_expression = "rate(5 minutes)"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSchedulerScheduleTimezoneRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
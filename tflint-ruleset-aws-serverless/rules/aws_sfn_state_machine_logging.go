@@ -0,0 +1,309 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// sfnLogLevels are the Step Functions log levels, from the least to the most verbose
+var sfnLogLevels = []string{"OFF", "FATAL", "ERROR", "ALL"}
+
+// awsSfnLoggingConfig is the rule configuration for Step Functions logging
+type awsSfnLoggingConfig struct {
+	MinLevel               string `hclext:"min_level,optional"`
+	AllowExecutionData     bool   `hclext:"allow_execution_data,optional"`
+	RequireStandardLogging bool   `hclext:"require_standard_logging,optional"`
+}
+
+// AwsSfnStateMachineLogging checks if logging is enabled for Step Functions
+type AwsSfnStateMachineLoggingRule struct {
+	tflint.DefaultRule
+	resourceType          string
+	blockName             string
+	levelAttrName         string
+	executionDataAttrName string
+	destinationAttrName   string
+	typeAttrName          string
+	expressType           string
+	logGroupResourceType  string
+	logGroupNameAttrName  string
+	logGroupPrefix        string
+}
+
+// NewAwsSfnStateMachineLoggingRule returns new rule with default attributes
+func NewAwsSfnStateMachineLoggingRule() *AwsSfnStateMachineLoggingRule {
+	return &AwsSfnStateMachineLoggingRule{
+		resourceType:          "aws_sfn_state_machine",
+		blockName:             "logging_configuration",
+		levelAttrName:         "level",
+		executionDataAttrName: "include_execution_data",
+		destinationAttrName:   "log_destination",
+		typeAttrName:          "type",
+		expressType:           "EXPRESS",
+		logGroupResourceType:  "aws_cloudwatch_log_group",
+		logGroupNameAttrName:  "name",
+		logGroupPrefix:        "/aws/vendedlogs/",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSfnStateMachineLoggingRule) Name() string {
+	return "aws_sfn_state_machine_logging"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSfnStateMachineLoggingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSfnStateMachineLoggingRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSfnStateMachineLoggingRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/step_functions/logging/"
+}
+
+// logGroupNames returns the names of the "aws_cloudwatch_log_group" resources, if known
+func (r *AwsSfnStateMachineLoggingRule) logGroupNames(runner tflint.Runner) (map[string]string, error) {
+	names := make(map[string]string)
+
+	resources, err := runner.GetResourceContent(r.logGroupResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.logGroupNameAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.logGroupNameAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var name string
+		if err := runner.EvaluateExpr(attr.Expr, &name, nil); err != nil {
+			return nil, err
+		}
+		names[resource.Labels[1]] = name
+	}
+
+	return names, nil
+}
+
+// checkDestination checks if the log destination is a log group with a vended logs name
+func (r *AwsSfnStateMachineLoggingRule) checkDestination(runner tflint.Runner, attr *hclext.Attribute, logGroups map[string]string) error {
+	var name string
+
+	if logGroupName, ok := resourceReference(attr.Expr, r.logGroupResourceType); ok {
+		if name, ok = logGroups[logGroupName]; !ok {
+			return nil
+		}
+	} else if referencesOtherResource(attr.Expr, r.logGroupResourceType) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should reference an %s.", r.destinationAttrName, r.logGroupResourceType),
+			attr.Expr.Range(),
+		)
+		return nil
+	} else if isStaticExpr(attr.Expr) {
+		var arn string
+		if err := runner.EvaluateExpr(attr.Expr, &arn, nil); err != nil {
+			return err
+		}
+
+		// arn:aws:logs:region:account:log-group:name:*
+		parts := strings.Split(arn, ":")
+		if len(parts) < 7 || parts[0] != "arn" || parts[2] != "logs" || parts[5] != "log-group" {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be the ARN of a CloudWatch Logs log group.", r.destinationAttrName),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+		name = parts[6]
+	} else {
+		return nil
+	}
+
+	if !strings.HasPrefix(name, r.logGroupPrefix) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be a log group with a name starting with \"%s\".", r.destinationAttrName, r.logGroupPrefix),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
+
+// checkLevel checks if the log level is at least the minimum level
+func (r *AwsSfnStateMachineLoggingRule) checkLevel(runner tflint.Runner, block *hclext.Block, config *awsSfnLoggingConfig) (bool, error) {
+	attr, ok := block.Body.Attributes[r.levelAttrName]
+	if !ok {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.levelAttrName),
+			block.DefRange,
+		)
+		return false, nil
+	}
+	if !isStaticExpr(attr.Expr) {
+		return true, nil
+	}
+
+	var level string
+	if err := runner.EvaluateExpr(attr.Expr, &level, nil); err != nil {
+		return false, err
+	}
+
+	if level == sfnLogLevels[0] {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should not be set to %s.", r.levelAttrName, level),
+			attr.Expr.Range(),
+		)
+		return false, nil
+	}
+
+	if sfnLogLevelIndex(level) < sfnLogLevelIndex(config.MinLevel) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be at least %s.", r.levelAttrName, config.MinLevel),
+			attr.Expr.Range(),
+		)
+	}
+
+	return true, nil
+}
+
+// sfnLogLevelIndex returns the verbosity of a log level, or -1 if it is unknown
+func sfnLogLevelIndex(level string) int {
+	for i, l := range sfnLogLevels {
+		if l == level {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Check checks if logging is enabled for Step Functions
+func (r *AwsSfnStateMachineLoggingRule) Check(runner tflint.Runner) error {
+	config := &awsSfnLoggingConfig{
+		MinLevel:               "FATAL",
+		AllowExecutionData:     true,
+		RequireStandardLogging: true,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if sfnLogLevelIndex(config.MinLevel) < 0 {
+		return fmt.Errorf("invalid \"min_level\" %q, expected one of %s", config.MinLevel, strings.Join(sfnLogLevels, ", "))
+	}
+
+	logGroups, err := r.logGroupNames(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.typeAttrName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: r.levelAttrName},
+						{Name: r.executionDataAttrName},
+						{Name: r.destinationAttrName},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		blocks := resource.Body.Blocks.OfType(r.blockName)
+		if len(blocks) == 0 {
+			// Express workflows have no execution history, so logs are the
+			// only record of their executions
+			var workflowType string
+			if attr, ok := resource.Body.Attributes[r.typeAttrName]; ok && isStaticExpr(attr.Expr) {
+				if err := runner.EvaluateExpr(attr.Expr, &workflowType, nil); err != nil {
+					return err
+				}
+			}
+
+			if workflowType == r.expressType {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present for an %s state machine.", r.blockName, r.expressType),
+					resource.DefRange,
+				)
+			} else if config.RequireStandardLogging {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present.", r.blockName),
+					resource.DefRange,
+				)
+			}
+			continue
+		}
+		block := blocks[0]
+
+		enabled, err := r.checkLevel(runner, block, config)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			continue
+		}
+
+		// Check execution data
+		if attr, ok := block.Body.Attributes[r.executionDataAttrName]; ok && !config.AllowExecutionData && isStaticExpr(attr.Expr) {
+			var includeData bool
+			if err := runner.EvaluateExpr(attr.Expr, &includeData, nil); err != nil {
+				return err
+			}
+
+			if includeData {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should not be set to true.", r.executionDataAttrName),
+					attr.Expr.Range(),
+				)
+			}
+		}
+
+		// Check log destination
+		attr, ok := block.Body.Attributes[r.destinationAttrName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.destinationAttrName),
+				block.DefRange,
+			)
+			continue
+		}
+
+		if err := r.checkDestination(runner, attr, logGroups); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,323 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSfnStateMachineLogging(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "logging to vended logs group",
+			Content: `
+resource "aws_cloudwatch_log_group" "this" {
+  name = "/aws/vendedlogs/states/my-state-machine"
+}
+
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    level                  = "ERROR"
+    include_execution_data = true
+    log_destination        = "${aws_cloudwatch_log_group.this.arn}:*"
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing logging_configuration",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"logging_configuration\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing logging_configuration for express workflow",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+  type = "EXPRESS"
+}
+`,
+			Config: `
+rule "aws_sfn_state_machine_logging" {
+  enabled                  = true
+  require_standard_logging = false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"logging_configuration\" is not present for an EXPRESS state machine.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "standard workflow without logging allowed",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+}
+`,
+			Config: `
+rule "aws_sfn_state_machine_logging" {
+  enabled                  = true
+  require_standard_logging = false
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "logging disabled",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    level = "OFF"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"level\" should not be set to OFF.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 13},
+						End:      hcl.Pos{Line: 6, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "level below minimum",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    level           = "FATAL"
+    log_destination = "arn:aws:logs:us-east-1:111122223333:log-group:/aws/vendedlogs/states/my-state-machine:*"
+  }
+}
+`,
+			Config: `
+rule "aws_sfn_state_machine_logging" {
+  enabled   = true
+  min_level = "ERROR"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"level\" should be at least ERROR.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 23},
+						End:      hcl.Pos{Line: 6, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing level",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    include_execution_data = false
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"level\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "execution data not allowed",
+			Content: `
+resource "aws_cloudwatch_log_group" "this" {
+  name = "/aws/vendedlogs/states/my-state-machine"
+}
+
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    level                  = "ALL"
+    include_execution_data = true
+    log_destination        = "${aws_cloudwatch_log_group.this.arn}:*"
+  }
+}
+`,
+			Config: `
+rule "aws_sfn_state_machine_logging" {
+  enabled              = true
+  allow_execution_data = false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"include_execution_data\" should not be set to true.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 30},
+						End:      hcl.Pos{Line: 11, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing log_destination",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    level = "ERROR"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"log_destination\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "log group without vended logs prefix",
+			Content: `
+resource "aws_cloudwatch_log_group" "this" {
+  name = "/my-state-machine"
+}
+
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    level           = "ERROR"
+    log_destination = "${aws_cloudwatch_log_group.this.arn}:*"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"log_destination\" should be a log group with a name starting with \"/aws/vendedlogs/\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 23},
+						End:      hcl.Pos{Line: 11, Column: 63},
+					},
+				},
+			},
+		},
+		{
+			Name: "log group arn without vended logs prefix",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    level           = "ERROR"
+    log_destination = "arn:aws:logs:us-east-1:111122223333:log-group:/my-state-machine:*"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"log_destination\" should be a log group with a name starting with \"/aws/vendedlogs/\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 7, Column: 90},
+					},
+				},
+			},
+		},
+		{
+			Name: "not a log group",
+			Content: `
+resource "aws_sfn_state_machine" "this" {
+  name = "my-state-machine"
+
+  logging_configuration {
+    level           = "ERROR"
+    log_destination = aws_s3_bucket.this.arn
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineLoggingRule(),
+					Message: "\"log_destination\" should reference an aws_cloudwatch_log_group.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 7, Column: 45},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsSfnStateMachineLoggingRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// snsDeliveryStatusFamily is a subscription protocol family with its delivery status logging attributes
+type snsDeliveryStatusFamily struct {
+	name      string
+	protocols []string
+	// prefix is the prefix of the feedback attributes on the topic
+	prefix string
+}
+
+// AwsSnsTopicDeliveryStatusLogging checks if SNS topics log the delivery status of their subscriptions
+type AwsSnsTopicDeliveryStatusLoggingRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	subscriptionType  string
+	topicAttrName     string
+	protocolAttrName  string
+	failureAttrSuffix string
+	successAttrSuffix string
+	families          []snsDeliveryStatusFamily
+}
+
+// NewAwsSnsTopicDeliveryStatusLoggingRule returns new rule with default attributes
+func NewAwsSnsTopicDeliveryStatusLoggingRule() *AwsSnsTopicDeliveryStatusLoggingRule {
+	return &AwsSnsTopicDeliveryStatusLoggingRule{
+		resourceType:      snsTopicType,
+		subscriptionType:  snsTopicSubscriptionType,
+		topicAttrName:     "topic_arn",
+		protocolAttrName:  "protocol",
+		failureAttrSuffix: "_failure_feedback_role_arn",
+		successAttrSuffix: "_success_feedback_role_arn",
+		families: []snsDeliveryStatusFamily{
+			{name: "Lambda", protocols: []string{"lambda"}, prefix: "lambda"},
+			{name: "SQS", protocols: []string{"sqs"}, prefix: "sqs"},
+			{name: "HTTP", protocols: []string{"http", "https"}, prefix: "http"},
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSnsTopicDeliveryStatusLoggingRule) Name() string {
+	return "aws_sns_topic_delivery_status_logging"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSnsTopicDeliveryStatusLoggingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSnsTopicDeliveryStatusLoggingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSnsTopicDeliveryStatusLoggingRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sns/delivery_status_logging/"
+}
+
+// topicProtocols returns the protocols of the subscriptions of each topic
+func (r *AwsSnsTopicDeliveryStatusLoggingRule) topicProtocols(runner tflint.Runner) (map[string]map[string]bool, error) {
+	protocols := make(map[string]map[string]bool)
+
+	resources, err := runner.GetResourceContent(r.subscriptionType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.topicAttrName},
+			{Name: r.protocolAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		topicAttr, ok := resource.Body.Attributes[r.topicAttrName]
+		if !ok {
+			continue
+		}
+		topicName, ok := resourceReference(topicAttr.Expr, snsTopicType)
+		if !ok {
+			continue
+		}
+
+		protocolAttr, ok := resource.Body.Attributes[r.protocolAttrName]
+		if !ok || !isStaticExpr(protocolAttr.Expr) {
+			continue
+		}
+		var protocol string
+		if err := runner.EvaluateExpr(protocolAttr.Expr, &protocol, nil); err != nil {
+			return nil, err
+		}
+
+		if protocols[topicName] == nil {
+			protocols[topicName] = make(map[string]bool)
+		}
+		protocols[topicName][protocol] = true
+	}
+
+	return protocols, nil
+}
+
+// Check checks if SNS topics log the delivery status of their subscriptions
+func (r *AwsSnsTopicDeliveryStatusLoggingRule) Check(runner tflint.Runner) error {
+	protocols, err := r.topicProtocols(runner)
+	if err != nil {
+		return err
+	}
+
+	attributes := []hclext.AttributeSchema{}
+	for _, family := range r.families {
+		attributes = append(attributes,
+			hclext.AttributeSchema{Name: family.prefix + r.failureAttrSuffix},
+			hclext.AttributeSchema{Name: family.prefix + r.successAttrSuffix},
+		)
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		subscribed, ok := protocols[resource.Labels[1]]
+		if !ok {
+			continue
+		}
+
+		for _, family := range r.families {
+			found := false
+			for _, protocol := range family.protocols {
+				found = found || subscribed[protocol]
+			}
+			if !found {
+				continue
+			}
+
+			for _, attrName := range []string{family.prefix + r.failureAttrSuffix, family.prefix + r.successAttrSuffix} {
+				if _, ok := resource.Body.Attributes[attrName]; !ok {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("\"%s\" is not present for a topic with %s subscriptions.", attrName, family.name),
+						resource.DefRange,
+					)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSnsTopicDeliveryStatusLogging(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "lambda subscription with logging",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name                             = "my-topic"
+  lambda_failure_feedback_role_arn = aws_iam_role.sns_logging.arn
+  lambda_success_feedback_role_arn = aws_iam_role.sns_logging.arn
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "lambda"
+  endpoint  = aws_lambda_function.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "lambda subscription without logging",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name                             = "my-topic"
+  lambda_failure_feedback_role_arn = aws_iam_role.sns_logging.arn
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "lambda"
+  endpoint  = aws_lambda_function.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicDeliveryStatusLoggingRule(),
+					Message: "\"lambda_success_feedback_role_arn\" is not present for a topic with Lambda subscriptions.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "sqs and https subscriptions without logging",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name = "my-topic"
+}
+
+resource "aws_sns_topic_subscription" "queue" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "sqs"
+  endpoint  = aws_sqs_queue.this.arn
+}
+
+resource "aws_sns_topic_subscription" "webhook" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "https"
+  endpoint  = "https://example.com/"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicDeliveryStatusLoggingRule(),
+					Message: "\"sqs_failure_feedback_role_arn\" is not present for a topic with SQS subscriptions.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+				{
+					Rule:    NewAwsSnsTopicDeliveryStatusLoggingRule(),
+					Message: "\"sqs_success_feedback_role_arn\" is not present for a topic with SQS subscriptions.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+				{
+					Rule:    NewAwsSnsTopicDeliveryStatusLoggingRule(),
+					Message: "\"http_failure_feedback_role_arn\" is not present for a topic with HTTP subscriptions.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+				{
+					Rule:    NewAwsSnsTopicDeliveryStatusLoggingRule(),
+					Message: "\"http_success_feedback_role_arn\" is not present for a topic with HTTP subscriptions.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "email subscription",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name = "my-topic"
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "email"
+  endpoint  = "ops@example.com"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "no subscriptions",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name = "my-topic"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSnsTopicDeliveryStatusLoggingRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSnsTopicEncryption checks if SNS topics are encrypted at rest
+type AwsSnsTopicEncryptionRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	kmsAttrName   string
+	publishAction string
+}
+
+// NewAwsSnsTopicEncryptionRule returns new rule with default attributes
+func NewAwsSnsTopicEncryptionRule() *AwsSnsTopicEncryptionRule {
+	return &AwsSnsTopicEncryptionRule{
+		resourceType:  snsTopicType,
+		kmsAttrName:   "kms_master_key_id",
+		publishAction: "sns:Publish",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSnsTopicEncryptionRule) Name() string {
+	return "aws_sns_topic_encryption"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSnsTopicEncryptionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSnsTopicEncryptionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSnsTopicEncryptionRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sns/encryption/"
+}
+
+// Check checks if SNS topics are encrypted at rest
+func (r *AwsSnsTopicEncryptionRule) Check(runner tflint.Runner) error {
+	config := &awsMessagingEncryptionConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	topics, err := awsSnsTopics(runner)
+	if err != nil {
+		return err
+	}
+
+	keys, err := awsKmsKeys(runner)
+	if err != nil {
+		return err
+	}
+
+	publishers, err := awsServicePublishers(runner, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.kmsAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.kmsAttrName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.kmsAttrName),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		managed, err := isAwsManagedKmsKey(runner, attr.Expr)
+		if err != nil {
+			return err
+		}
+		if managed && config.RequireCustomerManagedKey {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be a customer managed key.", r.kmsAttrName),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+
+		services, err := policyPublisherServices(runner, topics[resource.Labels[1]].policies, r.publishAction)
+		if err != nil {
+			return err
+		}
+		for service := range publishers[resource.Labels[1]] {
+			services[service] = true
+		}
+
+		if err := checkKmsKeyPublishers(runner, r, attr, keys, services); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSnsTopicEncryption(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing kms_master_key_id",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name = "my-topic"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicEncryptionRule(),
+					Message: "\"kms_master_key_id\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "aws managed key",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name              = "my-topic"
+  kms_master_key_id = "alias/aws/sns"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "aws managed key with customer managed key required",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name              = "my-topic"
+  kms_master_key_id = "alias/aws/sns"
+}
+`,
+			Config: `
+rule "aws_sns_topic_encryption" {
+  enabled                      = true
+  require_customer_managed_key = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicEncryptionRule(),
+					Message: "\"kms_master_key_id\" should be a customer managed key.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "default key policy with topic policy for eventbridge",
+			Content: `
+resource "aws_kms_key" "this" {
+}
+
+resource "aws_sns_topic" "this" {
+  name              = "my-topic"
+  kms_master_key_id = aws_kms_key.this.id
+}
+
+resource "aws_sns_topic_policy" "this" {
+  arn    = aws_sns_topic.this.arn
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sns:Publish",
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicEncryptionRule(),
+					Message: "\"kms_master_key_id\" key policy does not allow events.amazonaws.com to use the key.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 7, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "key policy for s3 notifications",
+			Content: `
+resource "aws_kms_key" "this" {
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "s3.amazonaws.com"},
+    "Action": ["kms:GenerateDataKey", "kms:Decrypt"],
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+
+resource "aws_sns_topic" "this" {
+  name              = "my-topic"
+  kms_master_key_id = aws_kms_key.this.id
+}
+
+resource "aws_s3_bucket_notification" "this" {
+  bucket = aws_s3_bucket.this.id
+
+  topic {
+    topic_arn = aws_sns_topic.this.arn
+    events    = ["s3:ObjectCreated:*"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "policies with boolean conditions",
+			Content: `
+resource "aws_kms_key" "this" {
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": ["kms:GenerateDataKey", "kms:Decrypt"],
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "kms:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": false}}
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_kms_key" "other" {
+}
+
+resource "aws_sns_topic" "this" {
+  name              = "my-topic"
+  kms_master_key_id = aws_kms_key.this.id
+}
+
+resource "aws_sns_topic" "other" {
+  name              = "my-other-topic"
+  kms_master_key_id = aws_kms_key.other.id
+}
+
+resource "aws_sns_topic_policy" "this" {
+  arn    = aws_sns_topic.this.arn
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": "sns:Publish",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sns:Publish",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": false}}
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_sns_topic_policy" "other" {
+  arn    = aws_sns_topic.other.arn
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": "sns:Publish",
+      "Resource": "*",
+      "Condition": {"NumericLessThan": {"aws:MultiFactorAuthAge": 3600}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicEncryptionRule(),
+					Message: "\"kms_master_key_id\" key policy does not allow events.amazonaws.com to use the key.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 35, Column: 23},
+						End:      hcl.Pos{Line: 35, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "computed key policy",
+			Content: `
+resource "aws_kms_key" "this" {
+  policy = data.aws_iam_policy_document.key.json
+}
+
+resource "aws_sns_topic" "this" {
+  name              = "my-topic"
+  kms_master_key_id = aws_kms_key.this.id
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  arn = aws_sns_topic.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSnsTopicEncryptionRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSnsTopicFifo checks if FIFO topics are consistently configured
+type AwsSnsTopicFifoRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	attributeName     string
+	nameAttrName      string
+	deduplicationName string
+}
+
+// NewAwsSnsTopicFifoRule returns new rule with default attributes
+func NewAwsSnsTopicFifoRule() *AwsSnsTopicFifoRule {
+	return &AwsSnsTopicFifoRule{
+		resourceType:      snsTopicType,
+		attributeName:     "fifo_topic",
+		nameAttrName:      "name",
+		deduplicationName: "content_based_deduplication",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSnsTopicFifoRule) Name() string {
+	return "aws_sns_topic_fifo"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSnsTopicFifoRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSnsTopicFifoRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSnsTopicFifoRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sns/fifo/"
+}
+
+// Check checks if FIFO topics are consistently configured
+func (r *AwsSnsTopicFifoRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+			{Name: r.nameAttrName},
+			{Name: r.deduplicationName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		fifo := false
+		if attr, ok := resource.Body.Attributes[r.attributeName]; ok {
+			if !isStaticExpr(attr.Expr) {
+				continue
+			}
+			if err := runner.EvaluateExpr(attr.Expr, &fifo, nil); err != nil {
+				return err
+			}
+		}
+
+		// The name of a FIFO topic should end with ".fifo"
+		if attr, ok := resource.Body.Attributes[r.nameAttrName]; ok && isStaticExpr(attr.Expr) {
+			var name string
+			if err := runner.EvaluateExpr(attr.Expr, &name, nil); err != nil {
+				return err
+			}
+
+			if fifo && !strings.HasSuffix(name, snsFifoSuffix) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should end with \"%s\" for a FIFO topic.", r.nameAttrName, snsFifoSuffix),
+					attr.Expr.Range(),
+				)
+			} else if !fifo && strings.HasSuffix(name, snsFifoSuffix) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should not end with \"%s\" for a standard topic.", r.nameAttrName, snsFifoSuffix),
+					attr.Expr.Range(),
+				)
+			}
+		}
+
+		// Publishers to a FIFO topic should provide a deduplication ID unless
+		// content-based deduplication is enabled, so the choice should be explicit
+		attr, ok := resource.Body.Attributes[r.deduplicationName]
+		if fifo {
+			if !ok {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present for a FIFO topic.", r.deduplicationName),
+					resource.DefRange,
+				)
+			}
+			continue
+		}
+
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+		var deduplication bool
+		if err := runner.EvaluateExpr(attr.Expr, &deduplication, nil); err != nil {
+			return err
+		}
+		if deduplication {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should not be enabled for a standard topic.", r.deduplicationName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSnsTopicFifo(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "fifo topic",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name                        = "my-topic.fifo"
+  fifo_topic                  = true
+  content_based_deduplication = false
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "standard topic",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name = "my-topic"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "fifo topic without suffix",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name                        = "my-topic"
+  fifo_topic                  = true
+  content_based_deduplication = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicFifoRule(),
+					Message: "\"name\" should end with \".fifo\" for a FIFO topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 33},
+						End:      hcl.Pos{Line: 3, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "standard topic with suffix",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name = "my-topic.fifo"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicFifoRule(),
+					Message: "\"name\" should not end with \".fifo\" for a standard topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing content_based_deduplication",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name       = "my-topic.fifo"
+  fifo_topic = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicFifoRule(),
+					Message: "\"content_based_deduplication\" is not present for a FIFO topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "content_based_deduplication on standard topic",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name                        = "my-topic"
+  content_based_deduplication = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicFifoRule(),
+					Message: "\"content_based_deduplication\" should not be enabled for a standard topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 33},
+						End:      hcl.Pos{Line: 4, Column: 37},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsSnsTopicFifoRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
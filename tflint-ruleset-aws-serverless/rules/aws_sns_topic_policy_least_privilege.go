@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSnsTopicPolicyLeastPrivilege checks if the resource policies of SNS topics follow least privilege
+type AwsSnsTopicPolicyLeastPrivilegeRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+	service       string
+}
+
+// NewAwsSnsTopicPolicyLeastPrivilegeRule returns new rule with default attributes
+func NewAwsSnsTopicPolicyLeastPrivilegeRule() *AwsSnsTopicPolicyLeastPrivilegeRule {
+	return &AwsSnsTopicPolicyLeastPrivilegeRule{
+		resourceTypes: []string{snsTopicType, snsTopicPolicyType},
+		service:       "sns",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSnsTopicPolicyLeastPrivilegeRule) Name() string {
+	return "aws_sns_topic_policy_least_privilege"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSnsTopicPolicyLeastPrivilegeRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSnsTopicPolicyLeastPrivilegeRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSnsTopicPolicyLeastPrivilegeRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sns/policy/"
+}
+
+// Check checks if the resource policies of SNS topics follow least privilege
+func (r *AwsSnsTopicPolicyLeastPrivilegeRule) Check(runner tflint.Runner) error {
+	return checkResourcePolicies(runner, r, r.resourceTypes, r.service)
+}
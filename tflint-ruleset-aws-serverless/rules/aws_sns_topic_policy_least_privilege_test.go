@@ -0,0 +1,205 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSnsTopicPolicyLeastPrivilege(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "least privilege policy",
+			Content: `
+resource "aws_sns_topic_policy" "this" {
+  arn    = aws_sns_topic.this.arn
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "s3.amazonaws.com"},
+      "Action": "sns:Publish",
+      "Resource": "*",
+      "Condition": {"StringEquals": {"aws:SourceAccount": "111122223333"}}
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sns:Publish",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "false"}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "boolean secure transport condition",
+			Content: `
+resource "aws_sns_topic_policy" "this" {
+  arn    = aws_sns_topic.this.arn
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": "sns:Publish",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sns:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": false}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "inverted secure transport condition",
+			Content: `
+resource "aws_sns_topic_policy" "this" {
+  arn    = aws_sns_topic.this.arn
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": "sns:Publish",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sns:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "true"}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicPolicyLeastPrivilegeRule(),
+					Message: "\"policy\" does not deny requests without \"aws:SecureTransport\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 23, Column: 7},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard action",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name   = "my-topic"
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+      "Action": "SNS:*",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sns:Publish",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "false"}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicPolicyLeastPrivilegeRule(),
+					Message: "\"policy\" should not allow all \"sns:*\" actions.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 23, Column: 7},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard principal",
+			Content: `
+resource "aws_sns_topic_policy" "this" {
+  arn    = aws_sns_topic.this.arn
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "*"},
+      "Action": "sns:Publish",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sns:Publish",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "false"}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicPolicyLeastPrivilegeRule(),
+					Message: "\"policy\" allows Principal \"*\" without a \"aws:SourceArn\" or \"aws:SourceAccount\" condition.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 23, Column: 7},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsSnsTopicPolicyLeastPrivilegeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSnsTopicSubscriptionConfirmationTimeout checks if HTTP subscriptions set a confirmation timeout
+type AwsSnsTopicSubscriptionConfirmationTimeoutRule struct {
+	tflint.DefaultRule
+	resourceType     string
+	attributeName    string
+	protocolAttrName string
+	protocols        []string
+}
+
+// NewAwsSnsTopicSubscriptionConfirmationTimeoutRule returns new rule with default attributes
+func NewAwsSnsTopicSubscriptionConfirmationTimeoutRule() *AwsSnsTopicSubscriptionConfirmationTimeoutRule {
+	return &AwsSnsTopicSubscriptionConfirmationTimeoutRule{
+		resourceType:     snsTopicSubscriptionType,
+		attributeName:    "confirmation_timeout_in_minutes",
+		protocolAttrName: "protocol",
+		protocols:        []string{"http", "https"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSnsTopicSubscriptionConfirmationTimeoutRule) Name() string {
+	return "aws_sns_topic_subscription_confirmation_timeout"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSnsTopicSubscriptionConfirmationTimeoutRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSnsTopicSubscriptionConfirmationTimeoutRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSnsTopicSubscriptionConfirmationTimeoutRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sns/subscriptions/"
+}
+
+// Check checks if HTTP subscriptions set a confirmation timeout
+func (r *AwsSnsTopicSubscriptionConfirmationTimeoutRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+			{Name: r.protocolAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if _, ok := resource.Body.Attributes[r.attributeName]; ok {
+			continue
+		}
+
+		attr, ok := resource.Body.Attributes[r.protocolAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var protocol string
+		if err := runner.EvaluateExpr(attr.Expr, &protocol, nil); err != nil {
+			return err
+		}
+
+		for _, httpProtocol := range r.protocols {
+			if protocol == httpProtocol {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present for an %s endpoint.", r.attributeName, protocol),
+					resource.DefRange,
+				)
+			}
+		}
+	}
+
+	return nil
+}
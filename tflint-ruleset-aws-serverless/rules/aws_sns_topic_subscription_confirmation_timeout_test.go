@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSnsTopicSubscriptionConfirmationTimeout(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "https without timeout",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = "https://example.com/"
+  protocol  = "https"
+  topic_arn = aws_sns_topic.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionConfirmationTimeoutRule(),
+					Message: "\"confirmation_timeout_in_minutes\" is not present for an https endpoint.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "https with timeout",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint                        = "https://example.com/"
+  protocol                        = "https"
+  topic_arn                       = aws_sns_topic.this.arn
+  confirmation_timeout_in_minutes = 5
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "sqs subscription",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = aws_sqs_queue.this.arn
+  protocol  = "sqs"
+  topic_arn = aws_sns_topic.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSnsTopicSubscriptionConfirmationTimeoutRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
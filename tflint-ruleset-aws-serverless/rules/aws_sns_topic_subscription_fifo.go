@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSnsTopicSubscriptionFifo checks if subscriptions to FIFO topics deliver to FIFO queues
+type AwsSnsTopicSubscriptionFifoRule struct {
+	tflint.DefaultRule
+	resourceType     string
+	topicAttrName    string
+	protocolAttrName string
+	endpointAttrName string
+	protocol         string
+}
+
+// NewAwsSnsTopicSubscriptionFifoRule returns new rule with default attributes
+func NewAwsSnsTopicSubscriptionFifoRule() *AwsSnsTopicSubscriptionFifoRule {
+	return &AwsSnsTopicSubscriptionFifoRule{
+		resourceType:     snsTopicSubscriptionType,
+		topicAttrName:    "topic_arn",
+		protocolAttrName: "protocol",
+		endpointAttrName: "endpoint",
+		protocol:         "sqs",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSnsTopicSubscriptionFifoRule) Name() string {
+	return "aws_sns_topic_subscription_fifo"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSnsTopicSubscriptionFifoRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSnsTopicSubscriptionFifoRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSnsTopicSubscriptionFifoRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sns/fifo/"
+}
+
+// endpointFifo returns whether the endpoint is a FIFO queue, and false if it is not known
+func (r *AwsSnsTopicSubscriptionFifoRule) endpointFifo(runner tflint.Runner, attr *hclext.Attribute, queues map[string]*awsSqsQueue) (bool, bool, error) {
+	if queueName, ok := resourceReference(attr.Expr, sqsQueueType); ok {
+		queue, ok := queues[queueName]
+		if !ok {
+			return false, false, nil
+		}
+		return queue.fifo, queue.fifoKnown, nil
+	}
+
+	if !isStaticExpr(attr.Expr) {
+		return false, false, nil
+	}
+
+	var arn string
+	if err := runner.EvaluateExpr(attr.Expr, &arn, nil); err != nil {
+		return false, false, err
+	}
+	if !isSqsQueueArn(arn) {
+		return false, false, nil
+	}
+
+	return strings.HasSuffix(arn, sqsFifoSuffix), true, nil
+}
+
+// Check checks if subscriptions to FIFO topics deliver to FIFO queues
+func (r *AwsSnsTopicSubscriptionFifoRule) Check(runner tflint.Runner) error {
+	topics, err := awsSnsTopics(runner)
+	if err != nil {
+		return err
+	}
+
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.topicAttrName},
+			{Name: r.protocolAttrName},
+			{Name: r.endpointAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		topicAttr, ok := resource.Body.Attributes[r.topicAttrName]
+		if !ok {
+			continue
+		}
+		topicName, ok := resourceReference(topicAttr.Expr, snsTopicType)
+		if !ok {
+			continue
+		}
+		topic, ok := topics[topicName]
+		if !ok || !topic.fifoKnown {
+			continue
+		}
+
+		protocolAttr, ok := resource.Body.Attributes[r.protocolAttrName]
+		if !ok || !isStaticExpr(protocolAttr.Expr) {
+			continue
+		}
+		var protocol string
+		if err := runner.EvaluateExpr(protocolAttr.Expr, &protocol, nil); err != nil {
+			return err
+		}
+
+		if protocol != r.protocol {
+			if topic.fifo {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should be %s for a FIFO topic.", r.protocolAttrName, r.protocol),
+					protocolAttr.Expr.Range(),
+				)
+			}
+			continue
+		}
+
+		endpointAttr, ok := resource.Body.Attributes[r.endpointAttrName]
+		if !ok {
+			continue
+		}
+		fifo, known, err := r.endpointFifo(runner, endpointAttr, queues)
+		if err != nil {
+			return err
+		}
+		if !known || fifo == topic.fifo {
+			continue
+		}
+
+		if topic.fifo {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be a FIFO queue for a FIFO topic.", r.endpointAttrName),
+				endpointAttr.Expr.Range(),
+			)
+		} else {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should not be a FIFO queue for a standard topic.", r.endpointAttrName),
+				endpointAttr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSnsTopicSubscriptionFifo(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "fifo topic to fifo queue",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name       = "my-topic.fifo"
+  fifo_topic = true
+}
+
+resource "aws_sqs_queue" "this" {
+  name       = "my-queue.fifo"
+  fifo_queue = true
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "sqs"
+  endpoint  = aws_sqs_queue.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "fifo topic to standard queue",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name       = "my-topic.fifo"
+  fifo_topic = true
+}
+
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "sqs"
+  endpoint  = aws_sqs_queue.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFifoRule(),
+					Message: "\"endpoint\" should be a FIFO queue for a FIFO topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 15},
+						End:      hcl.Pos{Line: 14, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "fifo topic to standard queue arn",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name       = "my-topic.fifo"
+  fifo_topic = true
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "sqs"
+  endpoint  = "arn:aws:sqs:us-east-1:111122223333:my-queue"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFifoRule(),
+					Message: "\"endpoint\" should be a FIFO queue for a FIFO topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 15},
+						End:      hcl.Pos{Line: 10, Column: 60},
+					},
+				},
+			},
+		},
+		{
+			Name: "fifo topic to lambda",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name       = "my-topic.fifo"
+  fifo_topic = true
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "lambda"
+  endpoint  = aws_lambda_function.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFifoRule(),
+					Message: "\"protocol\" should be sqs for a FIFO topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 15},
+						End:      hcl.Pos{Line: 9, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "standard topic to fifo queue",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name = "my-topic"
+}
+
+resource "aws_sqs_queue" "this" {
+  name       = "my-queue.fifo"
+  fifo_queue = true
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "sqs"
+  endpoint  = aws_sqs_queue.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFifoRule(),
+					Message: "\"endpoint\" should not be a FIFO queue for a standard topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 15},
+						End:      hcl.Pos{Line: 14, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "standard topic to lambda",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name = "my-topic"
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  topic_arn = aws_sns_topic.this.arn
+  protocol  = "lambda"
+  endpoint  = aws_lambda_function.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSnsTopicSubscriptionFifoRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
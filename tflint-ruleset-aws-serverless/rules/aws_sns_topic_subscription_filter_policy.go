@@ -0,0 +1,240 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSnsTopicSubscriptionFilterPolicy checks if SNS subscription filter policies are valid for their scope
+type AwsSnsTopicSubscriptionFilterPolicyRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	attributeName   string
+	scopeAttrName   string
+	attributesScope string
+	bodyScope       string
+	orKey           string
+	// unsupportedOperators are the EventBridge content filters that SNS
+	// filter policies do not support
+	unsupportedOperators map[string]bool
+}
+
+// NewAwsSnsTopicSubscriptionFilterPolicyRule returns new rule with default attributes
+func NewAwsSnsTopicSubscriptionFilterPolicyRule() *AwsSnsTopicSubscriptionFilterPolicyRule {
+	return &AwsSnsTopicSubscriptionFilterPolicyRule{
+		resourceType:    snsTopicSubscriptionType,
+		attributeName:   "filter_policy",
+		scopeAttrName:   "filter_policy_scope",
+		attributesScope: "MessageAttributes",
+		bodyScope:       "MessageBody",
+		orKey:           "$or",
+		unsupportedOperators: map[string]bool{
+			"wildcard": true,
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) Name() string {
+	return "aws_sns_topic_subscription_filter_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sns/subscriptions/"
+}
+
+// isFlat returns true if the filter policy only has message attributes at the top level
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) isFlat(object map[string]interface{}) bool {
+	for key, value := range object {
+		if key == r.orKey {
+			branches, _ := value.([]interface{})
+			for _, branch := range branches {
+				if branch, ok := branch.(map[string]interface{}); ok && !r.isFlat(branch) {
+					return false
+				}
+			}
+			continue
+		}
+
+		if _, ok := value.(map[string]interface{}); ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateOperators returns an error if the filter policy uses content
+// filters that are only supported by EventBridge event patterns
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) validateOperators(object map[string]interface{}, path string) error {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch value := object[key].(type) {
+		case map[string]interface{}:
+			if err := r.validateOperators(value, eventPatternPath(path, key)); err != nil {
+				return err
+			}
+		case []interface{}:
+			for _, item := range value {
+				item, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if key == r.orKey {
+					if err := r.validateOperators(item, path); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := r.validateFilter(item, eventPatternPath(path, key)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateFilter returns an error if a content filter, e.g. {"prefix": "foo"},
+// uses an operator that is only supported by EventBridge event patterns
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) validateFilter(filter map[string]interface{}, path string) error {
+	for operator, value := range filter {
+		if r.unsupportedOperators[operator] {
+			return fmt.Errorf("%q uses operator %q, which is not supported by SNS", path, operator)
+		}
+
+		// e.g. {"anything-but": {"wildcard": "*.png"}}
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// EventBridge supports {"prefix": {"equals-ignore-case": "foo"}}, SNS does not
+		if operator == "prefix" || operator == "suffix" {
+			return fmt.Errorf("%q uses operator %q with a nested operator, which is not supported by SNS", path, operator)
+		}
+		if err := r.validateFilter(nested, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scope returns the filter policy scope, or an empty string if it cannot be evaluated
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) scope(runner tflint.Runner, resource *hclext.Block) (string, error) {
+	attr, ok := resource.Body.Attributes[r.scopeAttrName]
+	if !ok {
+		// SNS applies filter policies to message attributes by default
+		return r.attributesScope, nil
+	}
+	if !isStaticExpr(attr.Expr) {
+		return "", nil
+	}
+
+	var scope string
+	if err := runner.EvaluateExpr(attr.Expr, &scope, nil); err != nil {
+		return "", err
+	}
+	if scope != r.attributesScope && scope != r.bodyScope {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be %s or %s.", r.scopeAttrName, r.attributesScope, r.bodyScope),
+			attr.Expr.Range(),
+		)
+		return "", nil
+	}
+
+	return scope, nil
+}
+
+// Check checks if SNS subscription filter policies are valid for their scope
+func (r *AwsSnsTopicSubscriptionFilterPolicyRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+			{Name: r.scopeAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.attributeName]
+		if !ok {
+			continue
+		}
+
+		scope, err := r.scope(runner, resource)
+		if err != nil {
+			return err
+		}
+
+		// Filter policies built from other resources cannot be inspected
+		if !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var document string
+		if err := runner.EvaluateExpr(attr.Expr, &document, nil); err != nil {
+			return err
+		}
+
+		// Filter policies use the same syntax as EventBridge event patterns
+		policy, err := parseEventPattern(document)
+		if err != nil {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not a valid filter policy: %s.", r.attributeName, err),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+		if err := r.validateOperators(policy, ""); err != nil {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not a valid filter policy: %s.", r.attributeName, err),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+
+		if scope == r.attributesScope && !r.isFlat(policy) {
+			// Nested policies are usually meant for the MessageBody scope
+			if _, ok := resource.Body.Attributes[r.scopeAttrName]; !ok {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not present.", r.scopeAttrName),
+					resource.DefRange,
+				)
+			}
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should not contain nested objects with the %s scope.", r.attributeName, r.attributesScope),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSnsTopicSubscriptionFilterPolicy(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "message attributes policy",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint            = aws_sqs_queue.this.arn
+  protocol            = "sqs"
+  topic_arn           = aws_sns_topic.this.arn
+  filter_policy_scope = "MessageAttributes"
+  filter_policy       = "{\"store\": [\"example_corp\"], \"price_usd\": [{\"numeric\": [\">=\", 100]}]}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "message body policy",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint            = aws_sqs_queue.this.arn
+  protocol            = "sqs"
+  topic_arn           = aws_sns_topic.this.arn
+  filter_policy_scope = "MessageBody"
+  filter_policy       = "{\"order\": {\"status\": [\"shipped\"]}}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing scope with nested policy",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint      = aws_sqs_queue.this.arn
+  protocol      = "sqs"
+  topic_arn     = aws_sns_topic.this.arn
+  filter_policy = "{\"order\": {\"status\": [\"shipped\"]}}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFilterPolicyRule(),
+					Message: "\"filter_policy_scope\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFilterPolicyRule(),
+					Message: "\"filter_policy\" should not contain nested objects with the MessageAttributes scope.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 19},
+						End:      hcl.Pos{Line: 6, Column: 61},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing scope with flat policy",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint      = aws_sqs_queue.this.arn
+  protocol      = "sqs"
+  topic_arn     = aws_sns_topic.this.arn
+  filter_policy = "{\"store\": [\"example_corp\"], \"event\": [{\"anything-but\": \"order_cancelled\"}]}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "eventbridge operators",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint            = aws_sqs_queue.this.arn
+  protocol            = "sqs"
+  topic_arn           = aws_sns_topic.this.arn
+  filter_policy_scope = "MessageBody"
+  filter_policy       = "{\"order\": {\"store\": [{\"wildcard\": \"example_*\"}]}}"
+}
+
+resource "aws_sns_topic_subscription" "other" {
+  endpoint            = aws_sqs_queue.this.arn
+  protocol            = "sqs"
+  topic_arn           = aws_sns_topic.this.arn
+  filter_policy_scope = "MessageAttributes"
+  filter_policy       = "{\"store\": [{\"prefix\": {\"equals-ignore-case\": \"example\"}}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFilterPolicyRule(),
+					Message: "\"filter_policy\" is not a valid filter policy: \"order.store\" uses operator \"wildcard\", which is not supported by SNS.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 25},
+						End:      hcl.Pos{Line: 7, Column: 84},
+					},
+				},
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFilterPolicyRule(),
+					Message: "\"filter_policy\" is not a valid filter policy: \"store\" uses operator \"prefix\" with a nested operator, which is not supported by SNS.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 25},
+						End:      hcl.Pos{Line: 15, Column: 93},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid policy",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint            = aws_sqs_queue.this.arn
+  protocol            = "sqs"
+  topic_arn           = aws_sns_topic.this.arn
+  filter_policy_scope = "MessageBody"
+  filter_policy       = "{\"store\": \"example_corp\"}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFilterPolicyRule(),
+					Message: "\"filter_policy\" is not a valid filter policy: \"store\" should be an array or an object.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 25},
+						End:      hcl.Pos{Line: 7, Column: 56},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid scope",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint            = aws_sqs_queue.this.arn
+  protocol            = "sqs"
+  topic_arn           = aws_sns_topic.this.arn
+  filter_policy_scope = "Body"
+  filter_policy       = "{\"store\": [\"example_corp\"]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionFilterPolicyRule(),
+					Message: "\"filter_policy_scope\" should be MessageAttributes or MessageBody.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 25},
+						End:      hcl.Pos{Line: 6, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "no filter policy",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = aws_sqs_queue.this.arn
+  protocol  = "sqs"
+  topic_arn = aws_sns_topic.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSnsTopicSubscriptionFilterPolicyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
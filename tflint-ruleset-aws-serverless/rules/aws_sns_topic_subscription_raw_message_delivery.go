@@ -0,0 +1,161 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSnsTopicSubscriptionRawMessageDelivery checks if SQS subscriptions consumed by Lambda functions use raw message delivery
+type AwsSnsTopicSubscriptionRawMessageDeliveryRule struct {
+	tflint.DefaultRule
+	resourceType        string
+	attributeName       string
+	protocolAttrName    string
+	endpointAttrName    string
+	protocol            string
+	mappingResourceType string
+	mappingAttrName     string
+}
+
+// NewAwsSnsTopicSubscriptionRawMessageDeliveryRule returns new rule with default attributes
+func NewAwsSnsTopicSubscriptionRawMessageDeliveryRule() *AwsSnsTopicSubscriptionRawMessageDeliveryRule {
+	return &AwsSnsTopicSubscriptionRawMessageDeliveryRule{
+		resourceType:        snsTopicSubscriptionType,
+		attributeName:       "raw_message_delivery",
+		protocolAttrName:    "protocol",
+		endpointAttrName:    "endpoint",
+		protocol:            "sqs",
+		mappingResourceType: "aws_lambda_event_source_mapping",
+		mappingAttrName:     "event_source_arn",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSnsTopicSubscriptionRawMessageDeliveryRule) Name() string {
+	return "aws_sns_topic_subscription_raw_message_delivery"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSnsTopicSubscriptionRawMessageDeliveryRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSnsTopicSubscriptionRawMessageDeliveryRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSnsTopicSubscriptionRawMessageDeliveryRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sns/subscriptions/"
+}
+
+// lambdaQueues returns the names of the queues consumed by Lambda functions
+func (r *AwsSnsTopicSubscriptionRawMessageDeliveryRule) lambdaQueues(runner tflint.Runner, queues map[string]*awsSqsQueue) (map[string]bool, error) {
+	consumed := make(map[string]bool)
+
+	resources, err := runner.GetResourceContent(r.mappingResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.mappingAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.mappingAttrName]
+		if !ok {
+			continue
+		}
+
+		queue, err := awsSqsQueueByExpr(runner, queues, attr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if queue != nil {
+			consumed[queue.resource.Labels[1]] = true
+		}
+	}
+
+	return consumed, nil
+}
+
+// Check checks if SQS subscriptions consumed by Lambda functions use raw message delivery
+func (r *AwsSnsTopicSubscriptionRawMessageDeliveryRule) Check(runner tflint.Runner) error {
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
+	consumed, err := r.lambdaQueues(runner, queues)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+			{Name: r.protocolAttrName},
+			{Name: r.endpointAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		protocolAttr, ok := resource.Body.Attributes[r.protocolAttrName]
+		if !ok || !isStaticExpr(protocolAttr.Expr) {
+			continue
+		}
+		var protocol string
+		if err := runner.EvaluateExpr(protocolAttr.Expr, &protocol, nil); err != nil {
+			return err
+		}
+		if protocol != r.protocol {
+			continue
+		}
+
+		endpointAttr, ok := resource.Body.Attributes[r.endpointAttrName]
+		if !ok {
+			continue
+		}
+		queue, err := awsSqsQueueByExpr(runner, queues, endpointAttr.Expr)
+		if err != nil {
+			return err
+		}
+		if queue == nil || !consumed[queue.resource.Labels[1]] {
+			continue
+		}
+
+		attr, ok := resource.Body.Attributes[r.attributeName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present for a queue consumed by Lambda.", r.attributeName),
+				resource.DefRange,
+			)
+			continue
+		}
+		if !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var raw bool
+		if err := runner.EvaluateExpr(attr.Expr, &raw, nil); err != nil {
+			return err
+		}
+		if !raw {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be true for a queue consumed by Lambda.", r.attributeName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSnsTopicSubscriptionRawMessageDelivery(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing raw_message_delivery",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+
+resource "aws_lambda_event_source_mapping" "this" {
+  event_source_arn = aws_sqs_queue.this.arn
+  function_name    = aws_lambda_function.this.arn
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = aws_sqs_queue.this.arn
+  protocol  = "sqs"
+  topic_arn = aws_sns_topic.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionRawMessageDeliveryRule(),
+					Message: "\"raw_message_delivery\" is not present for a queue consumed by Lambda.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 1},
+						End:      hcl.Pos{Line: 11, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "raw_message_delivery disabled",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+
+resource "aws_lambda_event_source_mapping" "this" {
+  event_source_arn = "arn:aws:sqs:us-east-1:111122223333:my-queue"
+  function_name    = aws_lambda_function.this.arn
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  endpoint             = aws_sqs_queue.this.arn
+  protocol             = "sqs"
+  topic_arn            = aws_sns_topic.this.arn
+  raw_message_delivery = false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionRawMessageDeliveryRule(),
+					Message: "\"raw_message_delivery\" should be true for a queue consumed by Lambda.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 26},
+						End:      hcl.Pos{Line: 15, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "raw_message_delivery enabled",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+
+resource "aws_lambda_event_source_mapping" "this" {
+  event_source_arn = aws_sqs_queue.this.arn
+  function_name    = aws_lambda_function.this.arn
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  endpoint             = aws_sqs_queue.this.arn
+  protocol             = "sqs"
+  topic_arn            = aws_sns_topic.this.arn
+  raw_message_delivery = true
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "queue not consumed by Lambda",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = aws_sqs_queue.this.arn
+  protocol  = "sqs"
+  topic_arn = aws_sns_topic.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSnsTopicSubscriptionRawMessageDeliveryRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
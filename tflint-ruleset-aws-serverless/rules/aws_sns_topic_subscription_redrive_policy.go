@@ -2,6 +2,7 @@ package rules
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -9,16 +10,27 @@ import (
 
 // AwsSnsTopicSubscriptionRedrivePolicyRule checks that an SNS subscription has a redrive policy configured
 type AwsSnsTopicSubscriptionRedrivePolicyRule struct {
-	resourceType  string
-	attributeName string
+	resourceType     string
+	attributeName    string
+	protocolAttrName string
+	topicAttrName    string
+	targetKey        string
+	servicePrincipal string
+	// ignoredProtocols deliver messages to people rather than applications
+	ignoredProtocols []string
 	tflint.DefaultRule
 }
 
 // NewAwsSnsTopicSubscriptionRedrivePolicyRule returns new rule with default attributes
 func NewAwsSnsTopicSubscriptionRedrivePolicyRule() *AwsSnsTopicSubscriptionRedrivePolicyRule {
 	return &AwsSnsTopicSubscriptionRedrivePolicyRule{
-		resourceType:  "aws_sns_topic_subscription",
-		attributeName: "redrive_policy",
+		resourceType:     snsTopicSubscriptionType,
+		attributeName:    "redrive_policy",
+		protocolAttrName: "protocol",
+		topicAttrName:    "topic_arn",
+		targetKey:        "deadLetterTargetArn",
+		servicePrincipal: "sns.amazonaws.com",
+		ignoredProtocols: []string{"email", "email-json", "sms"},
 	}
 }
 
@@ -42,11 +54,112 @@ func (r *AwsSnsTopicSubscriptionRedrivePolicyRule) Link() string {
 	return "https://awslabs.github.io/serverless-rules/rules/sns/redrive_policy/"
 }
 
+// isIgnoredProtocol returns true if the subscription protocol does not need a redrive policy
+func (r *AwsSnsTopicSubscriptionRedrivePolicyRule) isIgnoredProtocol(runner tflint.Runner, resource *hclext.Block) (bool, error) {
+	attr, ok := resource.Body.Attributes[r.protocolAttrName]
+	if !ok || !isStaticExpr(attr.Expr) {
+		return false, nil
+	}
+
+	var protocol string
+	if err := runner.EvaluateExpr(attr.Expr, &protocol, nil); err != nil {
+		return false, err
+	}
+
+	for _, ignored := range r.ignoredProtocols {
+		if protocol == ignored {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkDeadLetterQueue checks if the dead-letter queue is an SQS queue that SNS can send messages to
+func (r *AwsSnsTopicSubscriptionRedrivePolicyRule) checkDeadLetterQueue(runner tflint.Runner, resource *hclext.Block, attr *hclext.Attribute, policy *sqsRedrivePolicy, queues map[string]*awsSqsQueue, topics map[string]*awsSnsTopic) error {
+	fifo, fifoKnown := false, false
+
+	if queue, ok := policy.deadLetterQueue(queues); ok {
+		fifo, fifoKnown = queue.fifo, queue.fifoKnown
+
+		allowed, err := queue.allowsServiceSend(runner, r.servicePrincipal)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" queue policy does not allow %s to send messages.", r.targetKey, r.servicePrincipal),
+				attr.Expr.Range(),
+			)
+		}
+	} else if policy.deadLetterTargetExpr != nil && referencesOtherResource(policy.deadLetterTargetExpr, sqsQueueType) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should reference an %s.", r.targetKey, sqsQueueType),
+			attr.Expr.Range(),
+		)
+		return nil
+	} else if policy.deadLetterTargetArn != "" {
+		if !isSqsQueueArn(policy.deadLetterTargetArn) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be the ARN of an SQS queue.", r.targetKey),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+		fifo, fifoKnown = strings.HasSuffix(policy.deadLetterTargetArn, sqsFifoSuffix), true
+	}
+
+	// The dead-letter queue of a FIFO topic should be a FIFO queue
+	topicAttr, ok := resource.Body.Attributes[r.topicAttrName]
+	if !ok || !fifoKnown {
+		return nil
+	}
+	topicName, ok := resourceReference(topicAttr.Expr, snsTopicType)
+	if !ok {
+		return nil
+	}
+	topic, ok := topics[topicName]
+	if !ok || !topic.fifoKnown || topic.fifo == fifo {
+		return nil
+	}
+
+	if topic.fifo {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be a FIFO queue for a FIFO topic.", r.targetKey),
+			attr.Expr.Range(),
+		)
+	} else {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should not be a FIFO queue for a standard topic.", r.targetKey),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
+
 // Check checks that an SNS subscription has a redrive policy configured
 func (r *AwsSnsTopicSubscriptionRedrivePolicyRule) Check(runner tflint.Runner) error {
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
+	topics, err := awsSnsTopics(runner)
+	if err != nil {
+		return err
+	}
+
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: r.attributeName},
+			{Name: r.protocolAttrName},
+			{Name: r.topicAttrName},
 		},
 	}, nil)
 	if err != nil {
@@ -54,6 +167,14 @@ func (r *AwsSnsTopicSubscriptionRedrivePolicyRule) Check(runner tflint.Runner) e
 	}
 
 	for _, resource := range resources.Blocks {
+		ignored, err := r.isIgnoredProtocol(runner, resource)
+		if err != nil {
+			return err
+		}
+		if ignored {
+			continue
+		}
+
 		attr, exists := resource.Body.Attributes[r.attributeName]
 		if !exists {
 			runner.EmitIssue(
@@ -64,8 +185,33 @@ func (r *AwsSnsTopicSubscriptionRedrivePolicyRule) Check(runner tflint.Runner) e
 			continue
 		}
 
-		var attrValue string
-		if err := runner.EvaluateExpr(attr.Expr, &attrValue, nil); err != nil {
+		policy, err := evaluateSqsRedrivePolicy(runner, attr.Expr)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			continue
+		}
+
+		if policy.parseErr != nil {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not valid: %s.", r.attributeName, policy.parseErr),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+
+		if !policy.hasDeadLetterTarget {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.targetKey),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+
+		if err := r.checkDeadLetterQueue(runner, resource, attr, policy, queues, topics); err != nil {
 			return err
 		}
 	}
@@ -51,6 +51,169 @@ EOF
 `,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "email subscription",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = "ops@example.com"
+  protocol  = "email"
+  topic_arn = aws_sns_topic.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid json",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint       = aws_lambda_function.this.arn
+  protocol       = "lambda"
+  topic_arn      = aws_sns_topic.this.arn
+  redrive_policy = "{\"deadLetterTargetArn\": "
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionRedrivePolicyRule(),
+					Message: "\"redrive_policy\" is not valid: unexpected end of JSON input.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 20},
+						End:      hcl.Pos{Line: 6, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing deadLetterTargetArn",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint       = aws_lambda_function.this.arn
+  protocol       = "lambda"
+  topic_arn      = aws_sns_topic.this.arn
+  redrive_policy = "{}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 20},
+						End:      hcl.Pos{Line: 6, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "queue without policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = aws_lambda_function.this.arn
+  protocol  = "lambda"
+  topic_arn = aws_sns_topic.this.arn
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" queue policy does not allow sns.amazonaws.com to send messages.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 20},
+						End:      hcl.Pos{Line: 12, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			Name: "fifo topic with standard queue",
+			Content: `
+resource "aws_sns_topic" "this" {
+  name       = "my-topic.fifo"
+  fifo_topic = true
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name   = "my-dlq"
+  policy = data.aws_iam_policy_document.dlq.json
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = aws_sqs_queue.this.arn
+  protocol  = "sqs"
+  topic_arn = aws_sns_topic.this.arn
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" should be a FIFO queue for a FIFO topic.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 16, Column: 20},
+						End:      hcl.Pos{Line: 18, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			Name: "not a queue",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint  = aws_lambda_function.this.arn
+  protocol  = "lambda"
+  topic_arn = aws_sns_topic.this.arn
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sns_topic.dlq.arn
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" should reference an aws_sqs_queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 20},
+						End:      hcl.Pos{Line: 8, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			Name: "not a queue arn",
+			Content: `
+resource "aws_sns_topic_subscription" "this" {
+  endpoint       = aws_lambda_function.this.arn
+  protocol       = "lambda"
+  topic_arn      = aws_sns_topic.this.arn
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sns:us-east-1:111122223333:my-topic\"}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSnsTopicSubscriptionRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" should be the ARN of an SQS queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 20},
+						End:      hcl.Pos{Line: 6, Column: 96},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAwsSnsTopicSubscriptionRedrivePolicyRule()
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSqsQueueDlqNoRedrivePolicy checks that dead-letter queues do not have a redrive policy of their own
+type AwsSqsQueueDlqNoRedrivePolicyRule struct {
+	tflint.DefaultRule
+	attributeName string
+}
+
+// NewAwsSqsQueueDlqNoRedrivePolicyRule returns new rule with default attributes
+func NewAwsSqsQueueDlqNoRedrivePolicyRule() *AwsSqsQueueDlqNoRedrivePolicyRule {
+	return &AwsSqsQueueDlqNoRedrivePolicyRule{
+		attributeName: "redrive_policy",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSqsQueueDlqNoRedrivePolicyRule) Name() string {
+	return "aws_sqs_queue_dlq_no_redrive_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSqsQueueDlqNoRedrivePolicyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSqsQueueDlqNoRedrivePolicyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSqsQueueDlqNoRedrivePolicyRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sqs/dead_letter_queue/"
+}
+
+// Check checks that dead-letter queues do not have a redrive policy of their own
+func (r *AwsSqsQueueDlqNoRedrivePolicyRule) Check(runner tflint.Runner) error {
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
+	deadLetterQueues, err := awsSqsDeadLetterQueues(runner, queues)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(deadLetterQueues))
+	for name := range deadLetterQueues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, attr := range queues[name].redrivePolicies {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should not be set on a dead-letter queue.", r.attributeName),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSqsQueueDlqNoRedrivePolicy(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "dead-letter queue with redrive policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name           = "my-dlq"
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sqs:us-east-1:111122223333:other-dlq\", \"maxReceiveCount\": 4}"
+}
+
+resource "aws_pipes_pipe" "this" {
+  source_parameters {
+    kinesis_stream_parameters {
+      dead_letter_config {
+        arn = aws_sqs_queue.dlq.arn
+      }
+    }
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueDlqNoRedrivePolicyRule(),
+					Message: "\"redrive_policy\" should not be set on a dead-letter queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 20},
+						End:      hcl.Pos{Line: 4, Column: 121},
+					},
+				},
+			},
+		},
+		{
+			Name: "dead-letter queue without redrive policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSqsQueueDlqNoRedrivePolicyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSqsQueueDlqRedriveAllowPolicy checks if dead-letter queues restrict which queues can use them
+type AwsSqsQueueDlqRedriveAllowPolicyRule struct {
+	tflint.DefaultRule
+	attributeName string
+}
+
+// NewAwsSqsQueueDlqRedriveAllowPolicyRule returns new rule with default attributes
+func NewAwsSqsQueueDlqRedriveAllowPolicyRule() *AwsSqsQueueDlqRedriveAllowPolicyRule {
+	return &AwsSqsQueueDlqRedriveAllowPolicyRule{
+		attributeName: "redrive_allow_policy",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSqsQueueDlqRedriveAllowPolicyRule) Name() string {
+	return "aws_sqs_queue_dlq_redrive_allow_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSqsQueueDlqRedriveAllowPolicyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSqsQueueDlqRedriveAllowPolicyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSqsQueueDlqRedriveAllowPolicyRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sqs/dead_letter_queue/"
+}
+
+// Check checks if dead-letter queues restrict which queues can use them
+func (r *AwsSqsQueueDlqRedriveAllowPolicyRule) Check(runner tflint.Runner) error {
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
+	deadLetterQueues, err := awsSqsDeadLetterQueues(runner, queues)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(deadLetterQueues))
+	for name := range deadLetterQueues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// Redrive allow policies only restrict source queues, not other
+		// services sending failed events to the queue
+		queue := queues[name]
+		if len(deadLetterQueues[name]) == 0 || len(queue.redriveAllowPolicies) > 0 {
+			continue
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present for a dead-letter queue.", r.attributeName),
+			queue.resource.DefRange,
+		)
+	}
+
+	return nil
+}
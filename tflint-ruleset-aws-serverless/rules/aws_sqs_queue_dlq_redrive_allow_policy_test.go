@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSqsQueueDlqRedriveAllowPolicy(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing redrive allow policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueDlqRedriveAllowPolicyRule(),
+					Message: "\"redrive_allow_policy\" is not present for a dead-letter queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "inline redrive allow policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+  redrive_allow_policy = jsonencode({
+    redrivePermission = "byQueue"
+    sourceQueueArns   = [aws_sqs_queue.this.arn]
+  })
+}
+
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "redrive allow policy resource",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sqs_queue_redrive_allow_policy" "dlq" {
+  queue_url = aws_sqs_queue.dlq.id
+  redrive_allow_policy = jsonencode({
+    redrivePermission = "byQueue"
+    sourceQueueArns   = [aws_sqs_queue.this.arn]
+  })
+}
+
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+
+resource "aws_sqs_queue_redrive_policy" "this" {
+  queue_url = aws_sqs_queue.this.id
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "dead-letter queue of other services",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_scheduler_schedule" "this" {
+  target {
+    dead_letter_config {
+      arn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+    }
+  }
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+  })
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSqsQueueDlqRedriveAllowPolicyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// awsSqsQueueDlqRetentionConfig is the rule configuration for the retention of dead-letter queues
+type awsSqsQueueDlqRetentionConfig struct {
+	MinRetentionSeconds int `hclext:"min_retention_seconds,optional"`
+}
+
+// AwsSqsQueueDlqRetention checks if dead-letter queues keep messages longer than their source queues
+type AwsSqsQueueDlqRetentionRule struct {
+	tflint.DefaultRule
+	attributeName       string
+	minRetentionSeconds int
+}
+
+// NewAwsSqsQueueDlqRetentionRule returns new rule with default attributes
+func NewAwsSqsQueueDlqRetentionRule() *AwsSqsQueueDlqRetentionRule {
+	return &AwsSqsQueueDlqRetentionRule{
+		attributeName: "message_retention_seconds",
+		// Maximum retention period supported by SQS (14 days)
+		minRetentionSeconds: 1209600,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSqsQueueDlqRetentionRule) Name() string {
+	return "aws_sqs_queue_dlq_retention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSqsQueueDlqRetentionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSqsQueueDlqRetentionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSqsQueueDlqRetentionRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sqs/dead_letter_queue/"
+}
+
+// Check checks if dead-letter queues keep messages longer than their source queues
+func (r *AwsSqsQueueDlqRetentionRule) Check(runner tflint.Runner) error {
+	config := &awsSqsQueueDlqRetentionConfig{MinRetentionSeconds: r.minRetentionSeconds}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
+	deadLetterQueues, err := awsSqsDeadLetterQueues(runner, queues)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(deadLetterQueues))
+	for name := range deadLetterQueues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		queue := queues[name]
+		if !queue.retentionKnown {
+			continue
+		}
+
+		// Messages keep their original enqueue timestamp when moved to the dead-letter queue
+		required := config.MinRetentionSeconds
+		for _, source := range deadLetterQueues[name] {
+			if source.retentionKnown && source.retention > required {
+				required = source.retention
+			}
+		}
+
+		if queue.retention >= required {
+			continue
+		}
+
+		attr, ok := queue.resource.Body.Attributes[r.attributeName]
+		if !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not present.", r.attributeName),
+				queue.resource.DefRange,
+			)
+			continue
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be at least %d for a dead-letter queue.", r.attributeName, required),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSqsQueueDlqRetention(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "default retention",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueDlqRetentionRule(),
+					Message: "\"message_retention_seconds\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "maximum retention",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name                      = "my-dlq"
+  message_retention_seconds = 1209600
+}
+
+resource "aws_lambda_function" "this" {
+  dead_letter_config {
+    target_arn = aws_sqs_queue.dlq.arn
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "lower than the source queue",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name                      = "my-dlq"
+  message_retention_seconds = 86400
+}
+
+resource "aws_sqs_queue" "this" {
+  name                      = "my-queue"
+  message_retention_seconds = 172800
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Config: `
+rule "aws_sqs_queue_dlq_retention" {
+  enabled               = true
+  min_retention_seconds = 0
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueDlqRetentionRule(),
+					Message: "\"message_retention_seconds\" should be at least 172800 for a dead-letter queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "eventbridge dead-letter queue",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name                      = "my-dlq"
+  message_retention_seconds = 604800
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+    arn = aws_sqs_queue.dlq.arn
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueDlqRetentionRule(),
+					Message: "\"message_retention_seconds\" should be at least 1209600 for a dead-letter queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "not a dead-letter queue",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSqsQueueDlqRetentionRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
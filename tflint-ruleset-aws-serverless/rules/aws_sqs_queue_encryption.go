@@ -0,0 +1,161 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// awsMessagingEncryptionConfig is the rule configuration for the encryption of queues and topics
+type awsMessagingEncryptionConfig struct {
+	RequireCustomerManagedKey bool `hclext:"require_customer_managed_key,optional"`
+}
+
+// AwsSqsQueueEncryption checks if SQS queues are encrypted at rest
+type AwsSqsQueueEncryptionRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	kmsAttrName   string
+	sseAttrName   string
+	publishAction string
+}
+
+// NewAwsSqsQueueEncryptionRule returns new rule with default attributes
+func NewAwsSqsQueueEncryptionRule() *AwsSqsQueueEncryptionRule {
+	return &AwsSqsQueueEncryptionRule{
+		resourceType:  sqsQueueType,
+		kmsAttrName:   "kms_master_key_id",
+		sseAttrName:   "sqs_managed_sse_enabled",
+		publishAction: "sqs:SendMessage",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSqsQueueEncryptionRule) Name() string {
+	return "aws_sqs_queue_encryption"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSqsQueueEncryptionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSqsQueueEncryptionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSqsQueueEncryptionRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sqs/encryption/"
+}
+
+// checkManagedEncryption checks if SQS managed encryption is enabled on a queue without KMS key
+func (r *AwsSqsQueueEncryptionRule) checkManagedEncryption(runner tflint.Runner, resource *hclext.Block, config *awsMessagingEncryptionConfig) error {
+	if config.RequireCustomerManagedKey {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.kmsAttrName),
+			resource.DefRange,
+		)
+		return nil
+	}
+
+	attr, ok := resource.Body.Attributes[r.sseAttrName]
+	if !ok {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" or \"%s\" is not present.", r.sseAttrName, r.kmsAttrName),
+			resource.DefRange,
+		)
+		return nil
+	}
+	if !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var enabled bool
+	if err := runner.EvaluateExpr(attr.Expr, &enabled, nil); err != nil {
+		return err
+	}
+	if !enabled {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be true.", r.sseAttrName),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
+
+// Check checks if SQS queues are encrypted at rest
+func (r *AwsSqsQueueEncryptionRule) Check(runner tflint.Runner) error {
+	config := &awsMessagingEncryptionConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	queues, err := awsSqsQueues(runner)
+	if err != nil {
+		return err
+	}
+
+	keys, err := awsKmsKeys(runner)
+	if err != nil {
+		return err
+	}
+
+	publishers, err := awsServicePublishers(runner, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.kmsAttrName},
+			{Name: r.sseAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[r.kmsAttrName]
+		if !ok {
+			if err := r.checkManagedEncryption(runner, resource, config); err != nil {
+				return err
+			}
+			continue
+		}
+
+		managed, err := isAwsManagedKmsKey(runner, attr.Expr)
+		if err != nil {
+			return err
+		}
+		if managed && config.RequireCustomerManagedKey {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be a customer managed key.", r.kmsAttrName),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+
+		services, err := policyPublisherServices(runner, queues[resource.Labels[1]].policies, r.publishAction)
+		if err != nil {
+			return err
+		}
+		for service := range publishers[resource.Labels[1]] {
+			services[service] = true
+		}
+
+		if err := checkKmsKeyPublishers(runner, r, attr, keys, services); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
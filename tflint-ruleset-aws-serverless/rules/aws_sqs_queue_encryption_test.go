@@ -0,0 +1,243 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSqsQueueEncryption(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing encryption",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueEncryptionRule(),
+					Message: "\"sqs_managed_sse_enabled\" or \"kms_master_key_id\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "sqs managed encryption disabled",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name                    = "my-queue"
+  sqs_managed_sse_enabled = false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueEncryptionRule(),
+					Message: "\"sqs_managed_sse_enabled\" should be true.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 29},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "sqs managed encryption",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name                    = "my-queue"
+  sqs_managed_sse_enabled = true
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "customer managed key required",
+			Content: `
+resource "aws_sqs_queue" "managed" {
+  name                    = "my-queue"
+  sqs_managed_sse_enabled = true
+}
+
+resource "aws_sqs_queue" "alias" {
+  name              = "my-other-queue"
+  kms_master_key_id = "alias/aws/sqs"
+}
+`,
+			Config: `
+rule "aws_sqs_queue_encryption" {
+  enabled                      = true
+  require_customer_managed_key = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueEncryptionRule(),
+					Message: "\"kms_master_key_id\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 35},
+					},
+				},
+				{
+					Rule:    NewAwsSqsQueueEncryptionRule(),
+					Message: "\"kms_master_key_id\" should be a customer managed key.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 23},
+						End:      hcl.Pos{Line: 9, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "aws managed key with eventbridge target",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name              = "my-queue"
+  kms_master_key_id = "alias/aws/sqs"
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  arn = aws_sqs_queue.this.arn
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueEncryptionRule(),
+					Message: "\"kms_master_key_id\" should be a customer managed key to allow events.amazonaws.com.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "key policy without publishers",
+			Content: `
+resource "aws_kms_key" "this" {
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+    "Action": "kms:*",
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+
+resource "aws_sqs_queue" "this" {
+  name              = "my-queue"
+  kms_master_key_id = aws_kms_key.this.id
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  protocol = "sqs"
+  endpoint = aws_sqs_queue.this.arn
+}
+
+resource "aws_sqs_queue_policy" "this" {
+  queue_url = aws_sqs_queue.this.id
+  policy    = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "s3.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueEncryptionRule(),
+					Message: "\"kms_master_key_id\" key policy does not allow s3.amazonaws.com to use the key.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 18, Column: 23},
+						End:      hcl.Pos{Line: 18, Column: 42},
+					},
+				},
+				{
+					Rule:    NewAwsSqsQueueEncryptionRule(),
+					Message: "\"kms_master_key_id\" key policy does not allow sns.amazonaws.com to use the key.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 18, Column: 23},
+						End:      hcl.Pos{Line: 18, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "key policy with publishers",
+			Content: `
+resource "aws_kms_key" "this" {
+}
+
+resource "aws_kms_key_policy" "this" {
+  key_id = aws_kms_key.this.id
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": ["sns.amazonaws.com", "events.amazonaws.com"]},
+    "Action": ["kms:GenerateDataKey*", "kms:Decrypt"],
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+
+resource "aws_sqs_queue" "this" {
+  name              = "my-queue"
+  kms_master_key_id = aws_kms_key.this.arn
+}
+
+resource "aws_sns_topic_subscription" "this" {
+  protocol = "sqs"
+  endpoint = aws_sqs_queue.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSqsQueueEncryptionRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
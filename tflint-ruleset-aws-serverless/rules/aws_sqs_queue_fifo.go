@@ -0,0 +1,185 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSqsQueueFifo checks if FIFO queues are consistently configured
+type AwsSqsQueueFifoRule struct {
+	tflint.DefaultRule
+	resourceType           string
+	attributeName          string
+	nameAttrName           string
+	deduplicationName      string
+	deduplicationScopeName string
+	throughputLimitName    string
+	// highThroughputScope and highThroughputLimit are the values that enable
+	// high throughput for FIFO queues when used together
+	highThroughputScope string
+	highThroughputLimit string
+}
+
+// NewAwsSqsQueueFifoRule returns new rule with default attributes
+func NewAwsSqsQueueFifoRule() *AwsSqsQueueFifoRule {
+	return &AwsSqsQueueFifoRule{
+		resourceType:           sqsQueueType,
+		attributeName:          "fifo_queue",
+		nameAttrName:           "name",
+		deduplicationName:      "content_based_deduplication",
+		deduplicationScopeName: "deduplication_scope",
+		throughputLimitName:    "fifo_throughput_limit",
+		highThroughputScope:    "messageGroup",
+		highThroughputLimit:    "perMessageGroupId",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSqsQueueFifoRule) Name() string {
+	return "aws_sqs_queue_fifo"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSqsQueueFifoRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSqsQueueFifoRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSqsQueueFifoRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sqs/fifo/"
+}
+
+// checkThroughput checks if the deduplication scope and throughput limit both enable high throughput, or neither does
+func (r *AwsSqsQueueFifoRule) checkThroughput(runner tflint.Runner, resource *hclext.Block) error {
+	scopeAttr, scopeOk := resource.Body.Attributes[r.deduplicationScopeName]
+	limitAttr, limitOk := resource.Body.Attributes[r.throughputLimitName]
+	if !scopeOk && !limitOk {
+		return nil
+	}
+
+	var scope, limit string
+	if scopeOk {
+		if !isStaticExpr(scopeAttr.Expr) {
+			return nil
+		}
+		if err := runner.EvaluateExpr(scopeAttr.Expr, &scope, nil); err != nil {
+			return err
+		}
+	}
+	if limitOk {
+		if !isStaticExpr(limitAttr.Expr) {
+			return nil
+		}
+		if err := runner.EvaluateExpr(limitAttr.Expr, &limit, nil); err != nil {
+			return err
+		}
+	}
+
+	highScope, highLimit := scope == r.highThroughputScope, limit == r.highThroughputLimit
+	switch {
+	case highScope && !highLimit && limitOk:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be %s when \"%s\" is %s.", r.throughputLimitName, r.highThroughputLimit, r.deduplicationScopeName, r.highThroughputScope),
+			limitAttr.Expr.Range(),
+		)
+	case highScope && !highLimit:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present when \"%s\" is %s.", r.throughputLimitName, r.deduplicationScopeName, r.highThroughputScope),
+			resource.DefRange,
+		)
+	case highLimit && !highScope && scopeOk:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be %s when \"%s\" is %s.", r.deduplicationScopeName, r.highThroughputScope, r.throughputLimitName, r.highThroughputLimit),
+			scopeAttr.Expr.Range(),
+		)
+	case highLimit && !highScope:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present when \"%s\" is %s.", r.deduplicationScopeName, r.throughputLimitName, r.highThroughputLimit),
+			resource.DefRange,
+		)
+	}
+
+	return nil
+}
+
+// Check checks if FIFO queues are consistently configured
+func (r *AwsSqsQueueFifoRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+			{Name: r.nameAttrName},
+			{Name: r.deduplicationName},
+			{Name: r.deduplicationScopeName},
+			{Name: r.throughputLimitName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		fifo := false
+		if attr, ok := resource.Body.Attributes[r.attributeName]; ok {
+			if !isStaticExpr(attr.Expr) {
+				continue
+			}
+			if err := runner.EvaluateExpr(attr.Expr, &fifo, nil); err != nil {
+				return err
+			}
+		}
+
+		// The name of a FIFO queue should end with ".fifo"
+		if attr, ok := resource.Body.Attributes[r.nameAttrName]; ok && isStaticExpr(attr.Expr) {
+			var name string
+			if err := runner.EvaluateExpr(attr.Expr, &name, nil); err != nil {
+				return err
+			}
+
+			if fifo && !strings.HasSuffix(name, sqsFifoSuffix) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should end with \"%s\" for a FIFO queue.", r.nameAttrName, sqsFifoSuffix),
+					attr.Expr.Range(),
+				)
+			} else if !fifo && strings.HasSuffix(name, sqsFifoSuffix) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should not end with \"%s\" for a standard queue.", r.nameAttrName, sqsFifoSuffix),
+					attr.Expr.Range(),
+				)
+			}
+		}
+
+		if fifo {
+			if err := r.checkThroughput(runner, resource); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// These attributes are only supported by FIFO queues
+		for _, attrName := range []string{r.deduplicationName, r.deduplicationScopeName, r.throughputLimitName} {
+			if attr, ok := resource.Body.Attributes[attrName]; ok {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" should only be set for a FIFO queue.", attrName),
+					attr.Range,
+				)
+			}
+		}
+	}
+
+	return nil
+}
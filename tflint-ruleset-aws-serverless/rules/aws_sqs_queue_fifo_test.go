@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSqsQueueFifo(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "high throughput fifo queue",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name                  = "my-queue.fifo"
+  fifo_queue            = true
+  deduplication_scope   = "messageGroup"
+  fifo_throughput_limit = "perMessageGroupId"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "standard throughput fifo queue",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name                        = "my-queue.fifo"
+  fifo_queue                  = true
+  content_based_deduplication = true
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "fifo queue without suffix",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name       = "my-queue"
+  fifo_queue = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueFifoRule(),
+					Message: "\"name\" should end with \".fifo\" for a FIFO queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "standard queue with suffix",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name = "my-queue.fifo"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueFifoRule(),
+					Message: "\"name\" should not end with \".fifo\" for a standard queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "mismatched throughput limit",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name                  = "my-queue.fifo"
+  fifo_queue            = true
+  deduplication_scope   = "messageGroup"
+  fifo_throughput_limit = "perQueue"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueFifoRule(),
+					Message: "\"fifo_throughput_limit\" should be perMessageGroupId when \"deduplication_scope\" is messageGroup.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 27},
+						End:      hcl.Pos{Line: 6, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing deduplication scope",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name                  = "my-queue.fifo"
+  fifo_queue            = true
+  fifo_throughput_limit = "perMessageGroupId"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueFifoRule(),
+					Message: "\"deduplication_scope\" is not present when \"fifo_throughput_limit\" is perMessageGroupId.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "fifo attributes on standard queue",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name                = "my-queue"
+  deduplication_scope = "queue"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueFifoRule(),
+					Message: "\"deduplication_scope\" should only be set for a FIFO queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 32},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsSqsQueueFifoRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
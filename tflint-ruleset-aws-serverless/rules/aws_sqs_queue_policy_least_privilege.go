@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// AwsSqsQueuePolicyLeastPrivilege checks if the resource policies of SQS queues follow least privilege
+type AwsSqsQueuePolicyLeastPrivilegeRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+	service       string
+}
+
+// NewAwsSqsQueuePolicyLeastPrivilegeRule returns new rule with default attributes
+func NewAwsSqsQueuePolicyLeastPrivilegeRule() *AwsSqsQueuePolicyLeastPrivilegeRule {
+	return &AwsSqsQueuePolicyLeastPrivilegeRule{
+		resourceTypes: []string{sqsQueueType, sqsQueuePolicyType},
+		service:       "sqs",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSqsQueuePolicyLeastPrivilegeRule) Name() string {
+	return "aws_sqs_queue_policy_least_privilege"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSqsQueuePolicyLeastPrivilegeRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSqsQueuePolicyLeastPrivilegeRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSqsQueuePolicyLeastPrivilegeRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/sqs/policy/"
+}
+
+// Check checks if the resource policies of SQS queues follow least privilege
+func (r *AwsSqsQueuePolicyLeastPrivilegeRule) Check(runner tflint.Runner) error {
+	return checkResourcePolicies(runner, r, r.resourceTypes, r.service)
+}
@@ -0,0 +1,225 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSqsQueuePolicyLeastPrivilege(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "least privilege policy",
+			Content: `
+resource "aws_sqs_queue_policy" "this" {
+  queue_url = aws_sqs_queue.this.id
+  policy    = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": "sqs:SendMessage",
+      "Resource": "*",
+      "Condition": {"ArnEquals": {"aws:SourceArn": "arn:aws:events:us-east-1:111122223333:rule/my-rule"}}
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sqs:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "false"}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "boolean secure transport condition",
+			Content: `
+resource "aws_sqs_queue_policy" "this" {
+  queue_url = aws_sqs_queue.this.id
+  policy    = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": "sqs:SendMessage",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sqs:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": false}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "inverted secure transport condition",
+			Content: `
+resource "aws_sqs_queue_policy" "this" {
+  queue_url = aws_sqs_queue.this.id
+  policy    = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "events.amazonaws.com"},
+      "Action": "sqs:SendMessage",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sqs:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "true"}}
+    }
+  ]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueuePolicyLeastPrivilegeRule(),
+					Message: "\"policy\" does not deny requests without \"aws:SecureTransport\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 15},
+						End:      hcl.Pos{Line: 23, Column: 7},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard principal and action",
+			Content: `
+resource "aws_sqs_queue_policy" "this" {
+  queue_url = aws_sqs_queue.this.id
+  policy    = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "sqs:*",
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueuePolicyLeastPrivilegeRule(),
+					Message: "\"policy\" allows Principal \"*\" without a \"aws:SourceArn\" or \"aws:SourceAccount\" condition.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 15},
+						End:      hcl.Pos{Line: 14, Column: 7},
+					},
+				},
+				{
+					Rule:    NewAwsSqsQueuePolicyLeastPrivilegeRule(),
+					Message: "\"policy\" should not allow all \"sqs:*\" actions.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 15},
+						End:      hcl.Pos{Line: 14, Column: 7},
+					},
+				},
+				{
+					Rule:    NewAwsSqsQueuePolicyLeastPrivilegeRule(),
+					Message: "\"policy\" does not deny requests without \"aws:SecureTransport\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 15},
+						End:      hcl.Pos{Line: 14, Column: 7},
+					},
+				},
+			},
+		},
+		{
+			Name: "inline policy with source account",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name   = "my-queue"
+  policy = "{\"Statement\": [{\"Effect\": \"Allow\", \"Principal\": {\"AWS\": \"*\"}, \"Action\": [\"sqs:SendMessage\"], \"Resource\": \"*\", \"Condition\": {\"StringEquals\": {\"aws:SourceAccount\": \"111122223333\"}}}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueuePolicyLeastPrivilegeRule(),
+					Message: "\"policy\" does not deny requests without \"aws:SecureTransport\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 223},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid policy",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name   = "my-queue"
+  policy = "not a policy"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueuePolicyLeastPrivilegeRule(),
+					Message: "\"policy\" is not a valid IAM policy document.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "computed policy",
+			Content: `
+resource "aws_sqs_queue_policy" "this" {
+  queue_url = aws_sqs_queue.this.id
+  policy    = data.aws_iam_policy_document.queue.json
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSqsQueuePolicyLeastPrivilegeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -2,24 +2,45 @@ package rules
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
+// awsSqsQueueRedrivePolicyConfig is the rule configuration for the range of maxReceiveCount
+type awsSqsQueueRedrivePolicyConfig struct {
+	MinReceiveCount int `hclext:"min_receive_count,optional"`
+	MaxReceiveCount int `hclext:"max_receive_count,optional"`
+}
+
 // AwsSqsQueueRedrivePolicyRule checks if an SQS Queue has a redrive policy configured
 type AwsSqsQueueRedrivePolicyRule struct {
-	resourceType  string
-	attributeName string
+	resourceType       string
+	policyResourceType string
+	attributeName      string
+	queueURLAttrName   string
+	targetKey          string
+	receiveCountKey    string
+	minReceiveCount    int
+	maxReceiveCount    int
 	tflint.DefaultRule
 }
 
 // NewAwsSqsQueueRedrivePolicyRule returns new rule with default attributes
 func NewAwsSqsQueueRedrivePolicyRule() *AwsSqsQueueRedrivePolicyRule {
 	return &AwsSqsQueueRedrivePolicyRule{
-		resourceType:  "aws_sqs_queue",
-		attributeName: "redrive_policy",
+		resourceType:       sqsQueueType,
+		policyResourceType: sqsQueueRedrivePolicyType,
+		attributeName:      "redrive_policy",
+		queueURLAttrName:   "queue_url",
+		targetKey:          "deadLetterTargetArn",
+		receiveCountKey:    "maxReceiveCount",
+		// Limits supported by SQS
+		minReceiveCount: 1,
+		maxReceiveCount: 1000,
 	}
 }
 
@@ -43,78 +64,183 @@ func (r *AwsSqsQueueRedrivePolicyRule) Link() string {
 	return "https://awslabs.github.io/serverless-rules/rules/sqs/redrive_policy/"
 }
 
+// checkDeadLetterTarget checks if the dead-letter queue is an SQS queue of the same type as the source queue
+func (r *AwsSqsQueueRedrivePolicyRule) checkDeadLetterTarget(runner tflint.Runner, source *awsSqsQueue, attr *hclext.Attribute, policy *sqsRedrivePolicy, queues map[string]*awsSqsQueue) {
+	fifo, fifoKnown := false, false
+
+	if queue, ok := policy.deadLetterQueue(queues); ok {
+		fifo, fifoKnown = queue.fifo, queue.fifoKnown
+	} else if policy.deadLetterTargetExpr != nil && referencesOtherResource(policy.deadLetterTargetExpr, r.resourceType) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should reference an %s.", r.targetKey, r.resourceType),
+			attr.Expr.Range(),
+		)
+		return
+	} else if policy.deadLetterTargetArn != "" {
+		if !isSqsQueueArn(policy.deadLetterTargetArn) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" should be the ARN of an SQS queue.", r.targetKey),
+				attr.Expr.Range(),
+			)
+			return
+		}
+		fifo, fifoKnown = strings.HasSuffix(policy.deadLetterTargetArn, sqsFifoSuffix), true
+	}
+
+	// The type of queues attached through a static URL is unknown
+	if source == nil || !source.fifoKnown || !fifoKnown || source.fifo == fifo {
+		return
+	}
+
+	if source.fifo {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be a FIFO queue for a FIFO queue.", r.targetKey),
+			attr.Expr.Range(),
+		)
+	} else {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should not be a FIFO queue for a standard queue.", r.targetKey),
+			attr.Expr.Range(),
+		)
+	}
+}
+
+// checkRedrivePolicy checks the content of a redrive policy
+func (r *AwsSqsQueueRedrivePolicyRule) checkRedrivePolicy(runner tflint.Runner, source *awsSqsQueue, attr *hclext.Attribute, queues map[string]*awsSqsQueue, config *awsSqsQueueRedrivePolicyConfig) error {
+	policy, err := evaluateSqsRedrivePolicy(runner, attr.Expr)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate redrive policy: %w", err)
+	}
+	if policy == nil {
+		logger.Debug("Skipping redrive policy that cannot be evaluated statically")
+		return nil
+	}
+
+	if policy.parseErr != nil {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not valid: %s.", r.attributeName, policy.parseErr),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	if !policy.hasDeadLetterTarget {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.targetKey),
+			attr.Expr.Range(),
+		)
+	} else {
+		r.checkDeadLetterTarget(runner, source, attr, policy, queues)
+	}
+
+	switch {
+	case !policy.hasMaxReceiveCount:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not present.", r.receiveCountKey),
+			attr.Expr.Range(),
+		)
+	case policy.maxReceiveCount == nil:
+	case *policy.maxReceiveCount < config.MinReceiveCount:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should be at least %d.", r.receiveCountKey, config.MinReceiveCount),
+			attr.Expr.Range(),
+		)
+	case *policy.maxReceiveCount > config.MaxReceiveCount:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" should not exceed %d.", r.receiveCountKey, config.MaxReceiveCount),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
+
 // Check checks if an SQS Queue has a redrive policy configured
 func (r *AwsSqsQueueRedrivePolicyRule) Check(runner tflint.Runner) error {
-	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{
-			{Name: r.attributeName},
-		},
-	}, nil)
+	config := &awsSqsQueueRedrivePolicyConfig{
+		MinReceiveCount: r.minReceiveCount,
+		MaxReceiveCount: r.maxReceiveCount,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	queues, err := awsSqsQueues(runner)
 	if err != nil {
 		return fmt.Errorf("error getting resource content: %w", err)
 	}
 
-	logger.Debug(fmt.Sprintf("Found %d aws_sqs_queue resources", len(resources.Blocks)))
+	logger.Debug(fmt.Sprintf("Found %d aws_sqs_queue resources", len(queues)))
+
+	deadLetterQueues, err := awsSqsDeadLetterQueues(runner, queues)
+	if err != nil {
+		return fmt.Errorf("error getting dead-letter queues: %w", err)
+	}
+
+	names := make([]string, 0, len(queues))
+	for name := range queues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		queue := queues[name]
+		if len(queue.redrivePolicies) == 0 {
+			// Dead-letter queues do not need a dead-letter queue of their own
+			if _, ok := deadLetterQueues[name]; ok {
+				continue
+			}
 
-	for _, resource := range resources.Blocks {
-		attr, exists := resource.Body.Attributes[r.attributeName]
-		if !exists {
 			runner.EmitIssue(
 				r,
 				fmt.Sprintf("\"%s\" is not present.", r.attributeName),
-				resource.DefRange,
+				queue.resource.DefRange,
 			)
 			continue
 		}
 
-		var redrivePolicy string
-		err := runner.EvaluateExpr(attr.Expr, &redrivePolicy, nil)
-		if err != nil {
-			return fmt.Errorf("failed to evaluate redrive policy: %w", err)
+		for _, attr := range queue.redrivePolicies {
+			if err := r.checkRedrivePolicy(runner, queue, attr, queues, config); err != nil {
+				return err
+			}
 		}
+	}
 
-		// Validate that the redrive policy contains required fields
-		if redrivePolicy == "" {
-			runner.EmitIssue(
-				r,
-				"redrive_policy is empty",
-				attr.Expr.Range(),
-			)
+	// Redrive policies of queues that are not managed in this module
+	resources, err := runner.GetResourceContent(r.policyResourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.queueURLAttrName},
+			{Name: r.attributeName},
+		},
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("error getting resource content: %w", err)
+	}
+
+	for _, resource := range resources.Blocks {
+		if urlAttr, ok := resource.Body.Attributes[r.queueURLAttrName]; ok {
+			if queueName, ok := resourceReference(urlAttr.Expr, r.resourceType); ok && queues[queueName] != nil {
+				continue
+			}
+		}
+
+		attr, ok := resource.Body.Attributes[r.attributeName]
+		if !ok {
+			continue
+		}
+		if err := r.checkRedrivePolicy(runner, nil, attr, queues, config); err != nil {
+			return err
 		}
 	}
+
 	return nil
 }
-
-//func (r *AwsSqsQueueRedrivePolicyRule) Check(runner tflint.Runner) error {
-//	return runner.WalkResources(r.resourceType, func(resource *configs.Resource) error {
-//		// Attribute
-//		body, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
-//			Attributes: []hcl.AttributeSchema{
-//				{
-//					Name: r.attributeName,
-//				},
-//			},
-//		})
-//
-//		if diags.HasErrors() {
-//			return diags
-//		}
-//
-//		var attrValue string
-//		attribute, ok := body.Attributes[r.attributeName]
-//		if !ok {
-//			runner.EmitIssue(
-//				r,
-//				fmt.Sprintf("\"%s\" is not present.", r.attributeName),
-//				body.MissingItemRange,
-//			)
-//		} else {
-//			err := runner.EvaluateExpr(attribute.Expr, &attrValue, nil)
-//			if err != nil {
-//				return err
-//			}
-//		}
-//
-//		return nil
-//	})
-//}
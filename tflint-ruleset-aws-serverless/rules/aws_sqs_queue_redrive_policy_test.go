@@ -11,6 +11,7 @@ func Test_AwsSqsQueueRedrivePolicy(t *testing.T) {
 	cases := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -37,11 +38,344 @@ resource "aws_sqs_queue" "this" {
 resource "aws_sqs_queue" "this" {
   redrive_policy = <<EOF
 {
-  "deadLetterTargetArn": "my-sqs-arn",
+  "deadLetterTargetArn": "arn:aws:sqs:us-east-1:111122223333:my-dlq",
   "maxReceiveCount": 4
 }
 EOF
 }
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid json",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  redrive_policy = "{\"maxReceiveCount\": 4"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"redrive_policy\" is not valid: unexpected end of JSON input.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing keys",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  redrive_policy = "{}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"maxReceiveCount\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "maxReceiveCount is not an integer",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sqs:us-east-1:111122223333:my-dlq\", \"maxReceiveCount\": 4.7}"
+}
+
+resource "aws_sqs_queue" "other" {
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+    maxReceiveCount     = 4.7
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"redrive_policy\" is not valid: \"maxReceiveCount\" should be an integer.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 120},
+					},
+				},
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"redrive_policy\" is not valid: \"maxReceiveCount\" should be an integer.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 20},
+						End:      hcl.Pos{Line: 10, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			Name: "maxReceiveCount out of range",
+			Content: `
+resource "aws_sqs_queue" "low" {
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sqs:us-east-1:111122223333:my-dlq\", \"maxReceiveCount\": \"1\"}"
+}
+
+resource "aws_sqs_queue" "high" {
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sqs:us-east-1:111122223333:my-dlq\", \"maxReceiveCount\": 20}"
+}
+`,
+			Config: `
+rule "aws_sqs_queue_redrive_policy" {
+  enabled           = true
+  min_receive_count = 3
+  max_receive_count = 10
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"maxReceiveCount\" should be at least 3.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 122},
+					},
+				},
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"maxReceiveCount\" should not exceed 10.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 20},
+						End:      hcl.Pos{Line: 7, Column: 119},
+					},
+				},
+			},
+		},
+		{
+			Name: "jsonencode with queue reference",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name           = "my-dlq"
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sqs:us-east-1:111122223333:other-dlq\", \"maxReceiveCount\": 4}"
+}
+
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "fifo queue with standard dlq",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name           = "my-dlq"
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sqs:us-east-1:111122223333:other-dlq\", \"maxReceiveCount\": 4}"
+}
+
+resource "aws_sqs_queue" "this" {
+  name       = "my-queue.fifo"
+  fifo_queue = true
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" should be a FIFO queue for a FIFO queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 20},
+						End:      hcl.Pos{Line: 13, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			Name: "standard queue with fifo dlq arn",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sqs:us-east-1:111122223333:my-dlq.fifo\", \"maxReceiveCount\": 4}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" should not be a FIFO queue for a standard queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 123},
+					},
+				},
+			},
+		},
+		{
+			Name: "dlq resolved by arn",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name           = "my-dlq.fifo"
+  fifo_queue     = true
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sqs:us-east-1:111122223333:other-dlq.fifo\", \"maxReceiveCount\": 4}"
+}
+
+resource "aws_sqs_queue" "this" {
+  name       = "my-queue.fifo"
+  fifo_queue = true
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = "arn:aws:sqs:us-east-1:111122223333:my-dlq.fifo"
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "dlq is not a queue",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sns_topic.this.arn
+    maxReceiveCount     = 4
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" should reference an aws_sqs_queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 6, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			Name: "dlq arn is not a queue",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  redrive_policy = "{\"deadLetterTargetArn\": \"arn:aws:sns:us-east-1:111122223333:my-topic\", \"maxReceiveCount\": 4}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"deadLetterTargetArn\" should be the ARN of an SQS queue.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 120},
+					},
+				},
+			},
+		},
+		{
+			Name: "redrive policy resource",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+}
+
+resource "aws_sqs_queue_redrive_policy" "this" {
+  queue_url = aws_sqs_queue.this.id
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"maxReceiveCount\" is not present.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 20},
+						End:      hcl.Pos{Line: 10, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			Name: "redrive policy resource for an external queue",
+			Content: `
+resource "aws_sqs_queue_redrive_policy" "this" {
+  queue_url = var.queue_url
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = "arn:aws:sqs:us-east-1:111122223333:my-dlq"
+    maxReceiveCount     = 0
+  })
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSqsQueueRedrivePolicyRule(),
+					Message: "\"maxReceiveCount\" should be at least 1.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 20},
+						End:      hcl.Pos{Line: 7, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			Name: "dead-letter queues without redrive policy",
+			Content: `
+resource "aws_sqs_queue" "dlq" {
+  name = "my-dlq"
+}
+
+resource "aws_sqs_queue" "events_dlq" {
+  name = "my-events-dlq"
+}
+
+resource "aws_sqs_queue" "this" {
+  name = "my-queue"
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+
+resource "aws_cloudwatch_event_target" "this" {
+  dead_letter_config {
+    arn = aws_sqs_queue.events_dlq.arn
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "computed redrive policy",
+			Content: `
+resource "aws_sqs_queue" "this" {
+  redrive_policy = data.template_file.redrive.rendered
+}
 `,
 			Expected: helper.Issues{},
 		},
@@ -50,7 +384,11 @@ EOF
 	rule := NewAwsSqsQueueRedrivePolicyRule()
 
 	for _, tc := range cases {
-		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
 
 		if err := rule.Check(runner); err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// awsWafAssociationConfig is the rule configuration for WAF associations
+type awsWafAssociationConfig struct {
+	ExemptPrivate bool `hclext:"exempt_private,optional"`
+}
+
+// AwsWafv2WebACLAssociationMissing checks if public API Gateway stages and AppSync APIs are protected by a web ACL
+type AwsWafv2WebACLAssociationMissingRule struct {
+	tflint.DefaultRule
+	associationType     string
+	resourceArnAttrName string
+	restAPIAttrName     string
+	protectedTypes      []string
+}
+
+// NewAwsWafv2WebACLAssociationMissingRule returns new rule with default attributes
+func NewAwsWafv2WebACLAssociationMissingRule() *AwsWafv2WebACLAssociationMissingRule {
+	return &AwsWafv2WebACLAssociationMissingRule{
+		associationType:     "aws_wafv2_web_acl_association",
+		resourceArnAttrName: "resource_arn",
+		restAPIAttrName:     "rest_api_id",
+		protectedTypes: []string{
+			"aws_api_gateway_stage",
+			"aws_appsync_graphql_api",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsWafv2WebACLAssociationMissingRule) Name() string {
+	return "aws_wafv2_web_acl_association_missing"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsWafv2WebACLAssociationMissingRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsWafv2WebACLAssociationMissingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsWafv2WebACLAssociationMissingRule) Link() string {
+	return "https://awslabs.github.io/serverless-rules/rules/api_gateway/waf/"
+}
+
+// Check checks if public API Gateway stages and AppSync APIs are protected by a web ACL
+func (r *AwsWafv2WebACLAssociationMissingRule) Check(runner tflint.Runner) error {
+	config := &awsWafAssociationConfig{
+		ExemptPrivate: true,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	// Gather protected resources
+	protected := make(map[string]map[string]bool)
+	for _, protectedType := range r.protectedTypes {
+		protected[protectedType] = make(map[string]bool)
+	}
+
+	associations, err := runner.GetResourceContent(r.associationType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.resourceArnAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, association := range associations.Blocks {
+		attr, ok := association.Body.Attributes[r.resourceArnAttrName]
+		if !ok {
+			continue
+		}
+
+		for _, protectedType := range r.protectedTypes {
+			if name, ok := resourceReference(attr.Expr, protectedType); ok {
+				protected[protectedType][name] = true
+			}
+		}
+	}
+
+	privateAPIs := make(map[string]bool)
+	if config.ExemptPrivate {
+		privateAPIs, err = apiGatewayPrivateRestAPIs(runner)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, protectedType := range r.protectedTypes {
+		resources, err := runner.GetResourceContent(protectedType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: r.restAPIAttrName},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			if protected[protectedType][resource.Labels[1]] {
+				continue
+			}
+
+			// Skip stages of private REST APIs
+			if attr, ok := resource.Body.Attributes[r.restAPIAttrName]; ok {
+				if apiName, ok := resourceReference(attr.Expr, apiGatewayRestAPIType); ok && privateAPIs[apiName] {
+					continue
+				}
+			}
+
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not protected by an \"%s\".", protectedType, r.associationType),
+				resource.DefRange,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsWafv2WebACLAssociationMissing(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "unprotected stage and api",
+			Content: `
+resource "aws_api_gateway_stage" "this" {
+	stage_name = "prod"
+}
+
+resource "aws_appsync_graphql_api" "this" {
+	name = "my-api"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsWafv2WebACLAssociationMissingRule(),
+					Message: "\"aws_api_gateway_stage\" is not protected by an \"aws_wafv2_web_acl_association\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 40},
+					},
+				},
+				{
+					Rule:    NewAwsWafv2WebACLAssociationMissingRule(),
+					Message: "\"aws_appsync_graphql_api\" is not protected by an \"aws_wafv2_web_acl_association\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "protected stage and api",
+			Content: `
+resource "aws_api_gateway_stage" "this" {
+	stage_name = "prod"
+}
+
+resource "aws_appsync_graphql_api" "this" {
+	name = "my-api"
+}
+
+resource "aws_wafv2_web_acl_association" "stage" {
+	resource_arn = aws_api_gateway_stage.this.arn
+	web_acl_arn = aws_wafv2_web_acl.this.arn
+}
+
+resource "aws_wafv2_web_acl_association" "api" {
+	resource_arn = aws_appsync_graphql_api.this.arn
+	web_acl_arn = aws_wafv2_web_acl.this.arn
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private api is exempted",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["PRIVATE"]
+	}
+}
+
+resource "aws_api_gateway_stage" "this" {
+	stage_name = "prod"
+	rest_api_id = aws_api_gateway_rest_api.this.id
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private api is not exempted",
+			Content: `
+resource "aws_api_gateway_rest_api" "this" {
+	name = "my-api"
+	endpoint_configuration {
+		types = ["PRIVATE"]
+	}
+}
+
+resource "aws_api_gateway_stage" "this" {
+	stage_name = "prod"
+	rest_api_id = aws_api_gateway_rest_api.this.id
+}
+`,
+			Config: `
+rule "aws_wafv2_web_acl_association_missing" {
+	enabled = true
+	exempt_private = false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsWafv2WebACLAssociationMissingRule(),
+					Message: "\"aws_api_gateway_stage\" is not protected by an \"aws_wafv2_web_acl_association\".",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 1},
+						End:      hcl.Pos{Line: 9, Column: 40},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsWafv2WebACLAssociationMissingRule()
+
+	for _, tc := range cases {
+		files := map[string]string{"resource.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
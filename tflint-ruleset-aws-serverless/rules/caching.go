@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const (
+	apiGatewayStageType          = "aws_api_gateway_stage"
+	apiGatewayMethodSettingsType = "aws_api_gateway_method_settings"
+	cacheClusterAttrName         = "cache_cluster_enabled"
+	cachingEnabledAttrName       = "caching_enabled"
+)
+
+// apiGatewayCacheStages returns the "cache_cluster_enabled" attribute of
+// "aws_api_gateway_stage" resources with a cache cluster, by resource name.
+func apiGatewayCacheStages(runner tflint.Runner) (map[string]*hclext.Attribute, error) {
+	stages := make(map[string]*hclext.Attribute)
+
+	resources, err := runner.GetResourceContent(apiGatewayStageType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: cacheClusterAttrName},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, ok := resource.Body.Attributes[cacheClusterAttrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var enabled bool
+		if err := runner.EvaluateExpr(attr.Expr, &enabled, nil); err != nil {
+			return nil, err
+		}
+		if enabled {
+			stages[resource.Labels[1]] = attr
+		}
+	}
+
+	return stages, nil
+}
+
+// apiGatewayCachedMethodSettings returns the "settings" blocks of
+// "aws_api_gateway_method_settings" resources with caching enabled, either
+// explicitly or through the cache cluster of their stage. It also returns
+// the names of the stages referenced by these resources.
+func apiGatewayCachedMethodSettings(runner tflint.Runner, attrNames []string, stages map[string]*hclext.Attribute) ([]*hclext.Block, map[string]bool, error) {
+	var cached []*hclext.Block
+	referenced := make(map[string]bool)
+
+	attributes := []hclext.AttributeSchema{
+		{Name: cachingEnabledAttrName},
+	}
+	for _, attrName := range attrNames {
+		attributes = append(attributes, hclext.AttributeSchema{Name: attrName})
+	}
+
+	resources, err := runner.GetResourceContent(apiGatewayMethodSettingsType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "stage_name"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "settings",
+				Body: &hclext.BodySchema{Attributes: attributes},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		stageCached := false
+		if stageAttr, ok := resource.Body.Attributes["stage_name"]; ok {
+			if stageName, ok := resourceReference(stageAttr.Expr, apiGatewayStageType); ok {
+				referenced[stageName] = true
+				_, stageCached = stages[stageName]
+			}
+		}
+
+		for _, settings := range resource.Body.Blocks {
+			enabled := stageCached
+			if attr, ok := settings.Body.Attributes[cachingEnabledAttrName]; ok {
+				if !isStaticExpr(attr.Expr) {
+					continue
+				}
+				if err := runner.EvaluateExpr(attr.Expr, &enabled, nil); err != nil {
+					return nil, nil, err
+				}
+			}
+
+			if enabled {
+				cached = append(cached, settings)
+			}
+		}
+	}
+
+	return cached, referenced, nil
+}
@@ -0,0 +1,421 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// eventPatternEnvelopeFields are the top-level fields present in every event
+var eventPatternEnvelopeFields = map[string]bool{
+	"version":     true,
+	"id":          true,
+	"detail-type": true,
+	"source":      true,
+	"account":     true,
+	"time":        true,
+	"region":      true,
+}
+
+// eventPatternNumericOperators are the comparison operators of numeric matching
+var eventPatternNumericOperators = map[string]bool{
+	"<":  true,
+	"<=": true,
+	"=":  true,
+	">":  true,
+	">=": true,
+}
+
+// eventPattern is a parsed EventBridge event pattern
+type eventPattern map[string]interface{}
+
+// parseEventPattern parses and validates an EventBridge event pattern
+func parseEventPattern(document string) (eventPattern, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(document)))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %s", err)
+	}
+
+	pattern, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the pattern should be a JSON object")
+	}
+
+	if err := validateEventPatternObject(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	return pattern, nil
+}
+
+// matchesAll returns true if the pattern matches every event
+func (p eventPattern) matchesAll() bool {
+	return eventPatternObjectMatchesAll(p)
+}
+
+func eventPatternObjectMatchesAll(object map[string]interface{}) bool {
+	for key, value := range object {
+		if key == "$or" {
+			matchesAll := false
+			for _, branch := range value.([]interface{}) {
+				if eventPatternObjectMatchesAll(branch.(map[string]interface{})) {
+					matchesAll = true
+				}
+			}
+			if !matchesAll {
+				return false
+			}
+			continue
+		}
+
+		// Fields that are not always present can filter out events
+		if !eventPatternEnvelopeFields[key] {
+			return false
+		}
+
+		values, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+
+		matchesAll := false
+		for _, item := range values {
+			if eventPatternFilterMatchesAll(item) {
+				matchesAll = true
+			}
+		}
+		if !matchesAll {
+			return false
+		}
+	}
+
+	return true
+}
+
+// eventPatternFilterMatchesAll returns true if the content filter matches any
+// value of an envelope field
+func eventPatternFilterMatchesAll(item interface{}) bool {
+	filter, ok := item.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	switch {
+	case filter["prefix"] == "", filter["suffix"] == "", filter["wildcard"] == "*", filter["exists"] == true:
+		return true
+	}
+
+	return false
+}
+
+func eventPatternPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func validateEventPatternObject(object map[string]interface{}, path string) error {
+	if len(object) == 0 {
+		if path == "" {
+			return fmt.Errorf("the pattern should not be empty")
+		}
+		return fmt.Errorf("%q should not be empty", path)
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldPath := eventPatternPath(path, key)
+
+		switch value := object[key].(type) {
+		case map[string]interface{}:
+			if key == "$or" {
+				return fmt.Errorf("%q should be an array of patterns", fieldPath)
+			}
+			if err := validateEventPatternObject(value, fieldPath); err != nil {
+				return err
+			}
+		case []interface{}:
+			if key == "$or" {
+				if err := validateEventPatternOr(value, fieldPath); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := validateEventPatternValues(value, fieldPath); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%q should be an array or an object", fieldPath)
+		}
+	}
+
+	return nil
+}
+
+func validateEventPatternOr(branches []interface{}, path string) error {
+	if len(branches) < 2 {
+		return fmt.Errorf("%q should contain at least two patterns", path)
+	}
+
+	for _, branch := range branches {
+		object, ok := branch.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%q should be an array of patterns", path)
+		}
+		if err := validateEventPatternObject(object, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateEventPatternValues(values []interface{}, path string) error {
+	if len(values) == 0 {
+		return fmt.Errorf("%q should not be an empty array", path)
+	}
+
+	for _, value := range values {
+		switch value := value.(type) {
+		case []interface{}:
+			return fmt.Errorf("%q should not contain nested arrays", path)
+		case map[string]interface{}:
+			if err := validateEventPatternFilter(value, path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateEventPatternFilter validates a content filter, e.g. {"prefix": "foo"}
+func validateEventPatternFilter(filter map[string]interface{}, path string) error {
+	if len(filter) != 1 {
+		return fmt.Errorf("%q content filters should have exactly one operator", path)
+	}
+
+	for operator, value := range filter {
+		var valid bool
+
+		switch operator {
+		case "prefix", "suffix":
+			valid = isEventPatternString(value) || isEventPatternIgnoreCase(value)
+		case "equals-ignore-case", "wildcard", "cidr":
+			valid = isEventPatternString(value)
+		case "exists":
+			_, valid = value.(bool)
+		case "numeric":
+			valid = isEventPatternNumeric(value)
+		case "anything-but":
+			valid = isEventPatternAnythingBut(value)
+		default:
+			return fmt.Errorf("%q uses unknown operator %q", path, operator)
+		}
+
+		if !valid {
+			return fmt.Errorf("%q has an invalid value for operator %q", path, operator)
+		}
+	}
+
+	return nil
+}
+
+func isEventPatternString(value interface{}) bool {
+	_, ok := value.(string)
+	return ok
+}
+
+func isEventPatternIgnoreCase(value interface{}) bool {
+	object, ok := value.(map[string]interface{})
+	if !ok || len(object) != 1 {
+		return false
+	}
+	return isEventPatternString(object["equals-ignore-case"])
+}
+
+// isEventPatternNumeric validates numeric matching, e.g. [">", 0, "<=", 5]
+func isEventPatternNumeric(value interface{}) bool {
+	items, ok := value.([]interface{})
+	if !ok || (len(items) != 2 && len(items) != 4) {
+		return false
+	}
+
+	for i := 0; i < len(items); i += 2 {
+		operator, ok := items[i].(string)
+		if !ok || !eventPatternNumericOperators[operator] {
+			return false
+		}
+		if _, ok := items[i+1].(json.Number); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isEventPatternAnythingBut(value interface{}) bool {
+	switch value := value.(type) {
+	case string, json.Number:
+		return true
+	case []interface{}:
+		for _, item := range value {
+			switch item.(type) {
+			case string, json.Number:
+			default:
+				return false
+			}
+		}
+		return len(value) > 0
+	case map[string]interface{}:
+		if len(value) != 1 {
+			return false
+		}
+		for operator, item := range value {
+			switch operator {
+			case "prefix", "suffix", "wildcard", "equals-ignore-case":
+				if isEventPatternString(item) {
+					return true
+				}
+				// Lists of strings are also supported by these operators
+				items, ok := item.([]interface{})
+				if !ok || len(items) == 0 {
+					return false
+				}
+				for _, i := range items {
+					if !isEventPatternString(i) {
+						return false
+					}
+				}
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateScheduleExpression validates a rate() or cron() schedule expression
+func validateScheduleExpression(expression string) error {
+	switch {
+	case strings.HasPrefix(expression, "rate(") && strings.HasSuffix(expression, ")"):
+		return validateRateExpression(strings.TrimSuffix(strings.TrimPrefix(expression, "rate("), ")"))
+	case strings.HasPrefix(expression, "cron(") && strings.HasSuffix(expression, ")"):
+		return validateCronExpression(strings.TrimSuffix(strings.TrimPrefix(expression, "cron("), ")"))
+	}
+
+	return fmt.Errorf("should be a rate() or cron() expression")
+}
+
+func validateRateExpression(rate string) error {
+	parts := strings.Fields(rate)
+	if len(parts) != 2 {
+		return fmt.Errorf("rate() should have a value and a unit")
+	}
+
+	value, err := strconv.Atoi(parts[0])
+	if err != nil || value <= 0 {
+		return fmt.Errorf("rate() value should be a positive integer")
+	}
+
+	unit := parts[1]
+	switch strings.TrimSuffix(unit, "s") {
+	case "minute", "hour", "day":
+	default:
+		return fmt.Errorf("rate() unit should be minute(s), hour(s) or day(s)")
+	}
+
+	if value == 1 && strings.HasSuffix(unit, "s") {
+		return fmt.Errorf("rate() unit should be singular for a value of 1")
+	}
+	if value != 1 && !strings.HasSuffix(unit, "s") {
+		return fmt.Errorf("rate() unit should be plural for a value greater than 1")
+	}
+
+	return nil
+}
+
+func validateCronExpression(cron string) error {
+	fields := strings.Fields(cron)
+	if len(fields) != 6 {
+		return fmt.Errorf("cron() should have 6 fields, got %d", len(fields))
+	}
+
+	for _, field := range fields {
+		for _, c := range field {
+			if !strings.ContainsRune("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ,-*/?LW#", c) && !(c >= 'a' && c <= 'z') {
+				return fmt.Errorf("cron() field %q contains invalid characters", field)
+			}
+		}
+	}
+
+	// Day-of-month and day-of-week cannot both be set
+	dayOfMonth, dayOfWeek := fields[2], fields[4]
+	if (dayOfMonth == "?") == (dayOfWeek == "?") {
+		return fmt.Errorf("cron() should use \"?\" in exactly one of the day-of-month and day-of-week fields")
+	}
+
+	return nil
+}
+
+// awsEventBridgeRetryConfig is the rule configuration for retry policy limits
+type awsEventBridgeRetryConfig struct {
+	MaximumEventAgeInSeconds int `hclext:"maximum_event_age_in_seconds,optional"`
+	MaximumRetryAttempts     int `hclext:"maximum_retry_attempts,optional"`
+}
+
+// eventBridgeRetryLimit is the maximum value of a retry policy attribute
+type eventBridgeRetryLimit struct {
+	attrName string
+	max      int
+}
+
+// checkRetryPolicyLimits checks if a retry policy block sets all the given
+// attributes within their limits
+func checkRetryPolicyLimits(runner tflint.Runner, rule tflint.Rule, block *hclext.Block, limits []eventBridgeRetryLimit) error {
+	for _, limit := range limits {
+		attr, ok := block.Body.Attributes[limit.attrName]
+		if !ok {
+			runner.EmitIssue(
+				rule,
+				fmt.Sprintf("\"%s\" is not present.", limit.attrName),
+				block.DefRange,
+			)
+			continue
+		}
+
+		if !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var value int
+		if err := runner.EvaluateExpr(attr.Expr, &value, nil); err != nil {
+			return err
+		}
+
+		if value > limit.max {
+			runner.EmitIssue(
+				rule,
+				fmt.Sprintf("\"%s\" should not exceed %d.", limit.attrName, limit.max),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// awsIamStringList is a list of strings in an IAM policy, which can also be
+// written as a single string. Condition values can also be booleans and
+// numbers, e.g. with jsonencode(), which are converted to strings.
+type awsIamStringList []string
+
+// UnmarshalJSON decodes either a scalar or a list of scalars
+func (l *awsIamStringList) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	list := make(awsIamStringList, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			list = append(list, v)
+		case bool:
+			list = append(list, strconv.FormatBool(v))
+		case json.Number:
+			list = append(list, v.String())
+		default:
+			return fmt.Errorf("unexpected value %s", data)
+		}
+	}
+	*l = list
+	return nil
+}
+
+// awsIamPrincipal maps principal types (AWS, Service, ...) to their values.
+// The "*" principal is decoded as an "AWS" principal with a "*" value.
+type awsIamPrincipal map[string]awsIamStringList
+
+// UnmarshalJSON decodes either "*" or a map of principals
+func (p *awsIamPrincipal) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*p = awsIamPrincipal{"AWS": {value}}
+		return nil
+	}
+
+	var values map[string]awsIamStringList
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*p = values
+	return nil
+}
+
+// isWildcard returns true if the principal matches anyone
+func (p awsIamPrincipal) isWildcard() bool {
+	for _, value := range p["AWS"] {
+		if value == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+type awsIamPolicyStatement struct {
+	Sid       string                                 `json:"Sid"`
+	Effect    string                                 `json:"Effect"`
+	Principal awsIamPrincipal                        `json:"Principal"`
+	Action    awsIamStringList                       `json:"Action"`
+	Resource  awsIamStringList                       `json:"Resource"`
+	Condition map[string]map[string]awsIamStringList `json:"Condition"`
+}
+
+// hasConditionKey returns true if the statement has a condition on one of the
+// given keys, with any operator. Condition keys are case-insensitive.
+func (s awsIamPolicyStatement) hasConditionKey(keys ...string) bool {
+	for _, condition := range s.Condition {
+		for conditionKey := range condition {
+			for _, key := range keys {
+				if strings.EqualFold(conditionKey, key) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// hasCondition returns true if the statement has a condition on the given key
+// with one of the given operators. If values are given, the condition should
+// also match one of them. Condition keys are case-insensitive.
+func (s awsIamPolicyStatement) hasCondition(operators []string, key string, values ...string) bool {
+	for _, operator := range operators {
+		for conditionKey, conditionValues := range s.Condition[operator] {
+			if !strings.EqualFold(conditionKey, key) {
+				continue
+			}
+			if len(values) == 0 {
+				return true
+			}
+			for _, conditionValue := range conditionValues {
+				for _, value := range values {
+					if strings.EqualFold(conditionValue, value) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// awsIamPolicyStatements is a list of statements, which can also be written
+// as a single statement.
+type awsIamPolicyStatements []awsIamPolicyStatement
+
+// UnmarshalJSON decodes either a statement or a list of statements
+func (s *awsIamPolicyStatements) UnmarshalJSON(data []byte) error {
+	var statement awsIamPolicyStatement
+	if err := json.Unmarshal(data, &statement); err == nil {
+		*s = awsIamPolicyStatements{statement}
+		return nil
+	}
+
+	var statements []awsIamPolicyStatement
+	if err := json.Unmarshal(data, &statements); err != nil {
+		return err
+	}
+	*s = statements
+	return nil
+}
+
+type awsIamPolicy struct {
+	Version   string                 `json:"Version"`
+	Statement awsIamPolicyStatements `json:"Statement"`
+}
+
+// parseIamPolicy parses an IAM policy document
+func parseIamPolicy(document string) (*awsIamPolicy, error) {
+	policy := &awsIamPolicy{}
+	if err := json.Unmarshal([]byte(document), policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// hasConditionKey returns true if any statement has a condition on one of the given keys
+func (p *awsIamPolicy) hasConditionKey(keys ...string) bool {
+	for _, statement := range p.Statement {
+		if statement.hasConditionKey(keys...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowsAction returns true if the statement allows the action for the
+// service principal. Actions support IAM wildcards and are case-insensitive.
+func (s awsIamPolicyStatement) allowsAction(service, action string) bool {
+	if s.Effect != "Allow" {
+		return false
+	}
+
+	principalMatches := s.Principal.isWildcard()
+	for _, value := range s.Principal["Service"] {
+		if value == service {
+			principalMatches = true
+		}
+	}
+	if !principalMatches {
+		return false
+	}
+
+	for _, pattern := range s.Action {
+		if matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(action)); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowsServiceAction returns true if any statement allows the action for the service principal
+func (p *awsIamPolicy) allowsServiceAction(service, action string) bool {
+	for _, statement := range p.Statement {
+		if statement.allowsAction(service, action) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseIamPolicy(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Document  string
+		Condition map[string]map[string]awsIamStringList
+		Error     bool
+	}{
+		{
+			Name: "string condition",
+			Document: `{
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "sqs:SendMessage",
+    "Condition": {"ArnEquals": {"aws:SourceArn": "arn:aws:sns:us-east-1:111122223333:my-topic"}}
+  }
+}`,
+			Condition: map[string]map[string]awsIamStringList{
+				"ArnEquals": {"aws:SourceArn": {"arn:aws:sns:us-east-1:111122223333:my-topic"}},
+			},
+		},
+		{
+			Name: "bool condition",
+			Document: `{
+  "Statement": [{
+    "Effect": "Deny",
+    "Principal": "*",
+    "Action": "sqs:*",
+    "Condition": {"Bool": {"aws:SecureTransport": false}}
+  }]
+}`,
+			Condition: map[string]map[string]awsIamStringList{
+				"Bool": {"aws:SecureTransport": {"false"}},
+			},
+		},
+		{
+			Name: "numeric condition",
+			Document: `{
+  "Statement": [{
+    "Effect": "Deny",
+    "Principal": "*",
+    "Action": "s3:*",
+    "Condition": {"NumericLessThan": {"s3:TlsVersion": 1.2, "aws:MultiFactorAuthAge": [3600, "7200"]}}
+  }]
+}`,
+			Condition: map[string]map[string]awsIamStringList{
+				"NumericLessThan": {
+					"s3:TlsVersion":          {"1.2"},
+					"aws:MultiFactorAuthAge": {"3600", "7200"},
+				},
+			},
+		},
+		{
+			Name: "object condition value",
+			Document: `{
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "sqs:SendMessage",
+    "Condition": {"StringEquals": {"aws:SourceAccount": {"value": "111122223333"}}}
+  }]
+}`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		policy, err := parseIamPolicy(tc.Document)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("%s: expected an error", tc.Name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		if got := policy.Statement[0].Condition; !reflect.DeepEqual(got, tc.Condition) {
+			t.Fatalf("%s: expected condition %v, got %v", tc.Name, tc.Condition, got)
+		}
+	}
+}
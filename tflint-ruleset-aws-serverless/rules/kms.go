@@ -0,0 +1,277 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const (
+	kmsKeyType       = "aws_kms_key"
+	kmsKeyPolicyType = "aws_kms_key_policy"
+	// kmsAwsManagedAliasPrefix is the prefix of the aliases of AWS managed keys
+	kmsAwsManagedAliasPrefix = "alias/aws/"
+)
+
+// kmsPublisherServices are the service principals that publish messages to
+// SQS queues and SNS topics, and need access to their KMS key
+var kmsPublisherServices = []string{
+	"events.amazonaws.com",
+	"s3.amazonaws.com",
+	"sns.amazonaws.com",
+}
+
+// awsKmsKey is an "aws_kms_key" resource with its key policies
+type awsKmsKey struct {
+	resource *hclext.Block
+	// policies contains the static and dynamic policy attributes of the key,
+	// both inline and from "aws_kms_key_policy" resources
+	policies []*hclext.Attribute
+}
+
+// allowsService returns true if a key policy allows the service principal to
+// encrypt and decrypt messages. Keys without a policy use the default key
+// policy, which does not allow service principals. Policies that cannot be
+// evaluated statically are assumed to allow it.
+func (k *awsKmsKey) allowsService(runner tflint.Runner, service string) (bool, error) {
+	for _, attr := range k.policies {
+		if !isStaticExpr(attr.Expr) {
+			return true, nil
+		}
+
+		var document string
+		if err := runner.EvaluateExpr(attr.Expr, &document, nil); err != nil {
+			return false, err
+		}
+
+		policy, err := parseIamPolicy(document)
+		if err != nil {
+			continue
+		}
+		if policy.allowsServiceAction(service, "kms:GenerateDataKey") && policy.allowsServiceAction(service, "kms:Decrypt") {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// awsKmsKeys returns the "aws_kms_key" resources by name
+func awsKmsKeys(runner tflint.Runner) (map[string]*awsKmsKey, error) {
+	keys := make(map[string]*awsKmsKey)
+
+	resources, err := runner.GetResourceContent(kmsKeyType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "policy"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		key := &awsKmsKey{resource: resource}
+		if attr, ok := resource.Body.Attributes["policy"]; ok {
+			key.policies = append(key.policies, attr)
+		}
+		keys[resource.Labels[1]] = key
+	}
+
+	// Policies attached through separate resources
+	resources, err = runner.GetResourceContent(kmsKeyPolicyType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "key_id"},
+			{Name: "policy"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		keyAttr, ok := resource.Body.Attributes["key_id"]
+		if !ok {
+			continue
+		}
+		keyName, ok := resourceReference(keyAttr.Expr, kmsKeyType)
+		if !ok {
+			continue
+		}
+		key, ok := keys[keyName]
+		if !ok {
+			continue
+		}
+
+		if attr, ok := resource.Body.Attributes["policy"]; ok {
+			key.policies = append(key.policies, attr)
+		}
+	}
+
+	return keys, nil
+}
+
+// isAwsManagedKmsKey returns true if the expression is the alias of an AWS
+// managed key, such as "alias/aws/sqs"
+func isAwsManagedKmsKey(runner tflint.Runner, expr hcl.Expression) (bool, error) {
+	if !isStaticExpr(expr) {
+		return false, nil
+	}
+
+	var keyID string
+	if err := runner.EvaluateExpr(expr, &keyID, nil); err != nil {
+		return false, err
+	}
+
+	return strings.HasPrefix(keyID, kmsAwsManagedAliasPrefix), nil
+}
+
+// awsServicePublishers returns the service principals publishing messages to
+// the resources of the given type, by name: EventBridge targets, SNS
+// subscriptions and S3 bucket notifications
+func awsServicePublishers(runner tflint.Runner, resourceType string) (map[string]map[string]bool, error) {
+	publishers := make(map[string]map[string]bool)
+
+	for _, source := range []struct {
+		resourceType string
+		blockName    string
+		attrName     string
+		service      string
+	}{
+		{"aws_cloudwatch_event_target", "", "arn", "events.amazonaws.com"},
+		{snsTopicSubscriptionType, "", "endpoint", "sns.amazonaws.com"},
+		{"aws_s3_bucket_notification", "queue", "queue_arn", "s3.amazonaws.com"},
+		{"aws_s3_bucket_notification", "topic", "topic_arn", "s3.amazonaws.com"},
+	} {
+		schema := &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: source.attrName}},
+		}
+		if source.blockName != "" {
+			schema = &hclext.BodySchema{
+				Blocks: []hclext.BlockSchema{{Type: source.blockName, Body: schema}},
+			}
+		}
+
+		resources, err := runner.GetResourceContent(source.resourceType, schema, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		bodies := []*hclext.BodyContent{}
+		for _, resource := range resources.Blocks {
+			if source.blockName == "" {
+				bodies = append(bodies, resource.Body)
+				continue
+			}
+			for _, block := range resource.Body.Blocks.OfType(source.blockName) {
+				bodies = append(bodies, block.Body)
+			}
+		}
+
+		for _, body := range bodies {
+			attr, ok := body.Attributes[source.attrName]
+			if !ok {
+				continue
+			}
+			name, ok := resourceReference(attr.Expr, resourceType)
+			if !ok {
+				continue
+			}
+
+			if publishers[name] == nil {
+				publishers[name] = make(map[string]bool)
+			}
+			publishers[name][source.service] = true
+		}
+	}
+
+	return publishers, nil
+}
+
+// policyPublisherServices returns the publisher service principals allowed
+// to perform the action by static resource policies
+func policyPublisherServices(runner tflint.Runner, policies []*hclext.Attribute, action string) (map[string]bool, error) {
+	services := make(map[string]bool)
+
+	for _, attr := range policies {
+		if !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var document string
+		if err := runner.EvaluateExpr(attr.Expr, &document, nil); err != nil {
+			return nil, err
+		}
+
+		policy, err := parseIamPolicy(document)
+		if err != nil {
+			continue
+		}
+
+		// Only explicit service principals are relevant, as "*" would match all of them
+		for _, statement := range policy.Statement {
+			for _, service := range statement.Principal["Service"] {
+				if statement.allowsAction(service, action) {
+					services[service] = true
+				}
+			}
+		}
+	}
+
+	return services, nil
+}
+
+// checkKmsKeyPublishers checks if the KMS key of a queue or topic allows the
+// service principals publishing to it
+func checkKmsKeyPublishers(runner tflint.Runner, rule tflint.Rule, attr *hclext.Attribute, keys map[string]*awsKmsKey, services map[string]bool) error {
+	var names []string
+	for _, service := range kmsPublisherServices {
+		if services[service] {
+			names = append(names, service)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	// Service principals cannot be granted access to AWS managed keys
+	managed, err := isAwsManagedKmsKey(runner, attr.Expr)
+	if err != nil {
+		return err
+	}
+	if managed {
+		runner.EmitIssue(
+			rule,
+			fmt.Sprintf("\"%s\" should be a customer managed key to allow %s.", attr.Name, strings.Join(names, ", ")),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	keyName, ok := resourceReference(attr.Expr, kmsKeyType)
+	if !ok {
+		return nil
+	}
+	key, ok := keys[keyName]
+	if !ok {
+		return nil
+	}
+
+	for _, service := range names {
+		allowed, err := key.allowsService(runner, service)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			runner.EmitIssue(
+				rule,
+				fmt.Sprintf("\"%s\" key policy does not allow %s to use the key.", attr.Name, service),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -5,14 +5,37 @@ import (
 )
 
 var Rules = []tflint.Rule{
+	NewAwsAPIGatewayBasePathMappingStageRule(),
+	NewAwsAPIGatewayDomainNameTLSRule(),
+	NewAwsAPIGatewayIntegrationLambdaTimeoutRule(),
+	NewAwsAPIGatewayIntegrationResponseCorsRule(),
+	NewAwsAPIGatewayMethodRequestValidationRule(),
+	NewAwsAPIGatewayMethodSettingsCacheEncryptionRule(),
+	NewAwsAPIGatewayMethodSettingsCacheSettingsRule(),
 	NewAwsAPIGatewayMethodSettingsThrottlingRule(),
+	NewAwsAPIGatewayRestAPIExecuteAPIEndpointRule(),
+	NewAwsAPIGatewayRestAPIPrivatePolicyRule(),
 	NewAwsAPIGatewayStageLoggingRule(),
 	NewAwsAPIGatewayStageTracingRule(),
 	NewAwsAPIGatewayStageV2LoggingRule(),
 	NewAwsApigatewayStageStructuredLoggingRule(),
+	NewAwsApigatewayV2APICorsRule(),
 	NewAwsApigatewayV2StageStructuredLoggingRule(),
 	NewAwsApigatewayV2StageThrottlingRule(),
+	NewAwsAppsyncAPIKeyExpiryRule(),
+	NewAwsAppsyncDatasourceServiceRoleRule(),
+	NewAwsAppsyncGraphqlAPIAPIKeyAuthRule(),
+	NewAwsAppsyncGraphqlAPIAuthProviderConfigRule(),
+	NewAwsAppsyncGraphqlAPILoggingRule(),
+	NewAwsAppsyncGraphqlAPIResolverCoverageRule(),
 	NewAwsAppsyncGraphqlAPITracingRule(),
+	NewAwsAppsyncResolverBatchingRule(),
+	NewAwsAppsyncResolverCachingRule(),
+	NewAwsAppsyncResolverRuntimeRule(),
+	NewAwsCloudwatchEventBusArchiveRule(),
+	NewAwsCloudwatchEventBusPolicyPrincipalRule(),
+	NewAwsCloudwatchEventRuleEventPatternRule(),
+	NewAwsCloudwatchEventRuleScheduleExpressionRule(),
 	NewAwsCloudwatchEventTargetNoDlqRule(),
 	NewAwsCloudwatchLogGroupLambdaRetentionRule(),
 	NewAwsIamRoleLambdaNoStarRule(),
@@ -23,7 +46,29 @@ var Rules = []tflint.Rule{
 	NewAwsLambdaFunctionEolRuntimeRule(),
 	NewAwsLambdaFunctionTracingRule(),
 	NewAwsLambdaPermissionMultiplePrincipalsRule(),
+	NewAwsPipesPipeLoggingRule(),
+	NewAwsPipesPipeNoDlqRule(),
+	NewAwsSchedulerScheduleFlexibleTimeWindowRule(),
+	NewAwsSchedulerScheduleKmsKeyRule(),
+	NewAwsSchedulerScheduleNoDlqRule(),
+	NewAwsSchedulerScheduleTimezoneRule(),
+	NewAwsSfnStateMachineLoggingRule(),
 	NewAwsSfnStateMachineTracingRule(),
+	NewAwsSnsTopicDeliveryStatusLoggingRule(),
+	NewAwsSnsTopicEncryptionRule(),
+	NewAwsSnsTopicFifoRule(),
+	NewAwsSnsTopicPolicyLeastPrivilegeRule(),
+	NewAwsSnsTopicSubscriptionConfirmationTimeoutRule(),
+	NewAwsSnsTopicSubscriptionFifoRule(),
+	NewAwsSnsTopicSubscriptionFilterPolicyRule(),
+	NewAwsSnsTopicSubscriptionRawMessageDeliveryRule(),
 	NewAwsSnsTopicSubscriptionRedrivePolicyRule(),
+	NewAwsSqsQueueDlqNoRedrivePolicyRule(),
+	NewAwsSqsQueueDlqRedriveAllowPolicyRule(),
+	NewAwsSqsQueueDlqRetentionRule(),
+	NewAwsSqsQueueEncryptionRule(),
+	NewAwsSqsQueueFifoRule(),
+	NewAwsSqsQueuePolicyLeastPrivilegeRule(),
 	NewAwsSqsQueueRedrivePolicyRule(),
+	NewAwsWafv2WebACLAssociationMissingRule(),
 }
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// resourceReference returns the name of the first resource of the given type
+// referenced by the expression, e.g. "this" for "aws_lambda_function.this.arn".
+func resourceReference(expr hcl.Expression, resourceType string) (string, bool) {
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() != resourceType || len(traversal) < 2 {
+			continue
+		}
+
+		if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+			return attr.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// isStaticExpr returns true if the expression can be evaluated without
+// knowing the values of other resources.
+func isStaticExpr(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() != "var" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// referencesOtherResource returns true if the expression references a managed
+// resource other than the given type, e.g. "aws_sns_topic.this.arn" when
+// expecting an "aws_sqs_queue".
+func referencesOtherResource(expr hcl.Expression, resourceType string) bool {
+	for _, traversal := range expr.Variables() {
+		switch root := traversal.RootName(); root {
+		case resourceType, "var", "local", "data", "module", "each", "count", "path", "terraform", "self":
+			continue
+		default:
+			if strings.HasPrefix(root, "aws_") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// sourceConditionKeys are the condition keys restricting access to AWS
+// services acting on behalf of specific resources or accounts
+var sourceConditionKeys = []string{"aws:SourceArn", "aws:SourceAccount"}
+
+// secureTransportConditionKey is the condition key denying requests over HTTP
+const secureTransportConditionKey = "aws:SecureTransport"
+
+// secureTransportOperators are the condition operators matching requests
+// over HTTP when the secure transport condition is "false"
+var secureTransportOperators = []string{"Bool", "BoolIfExists"}
+
+// checkResourcePolicies checks that the resource policies of queues and
+// topics follow least privilege. Policies are read from the "policy"
+// attribute of the given resource types.
+func checkResourcePolicies(runner tflint.Runner, rule tflint.Rule, resourceTypes []string, service string) error {
+	for _, resourceType := range resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "policy"},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attr, ok := resource.Body.Attributes["policy"]
+			if !ok {
+				continue
+			}
+
+			if err := checkResourcePolicy(runner, rule, attr, service); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkResourcePolicy checks a resource policy for wildcard principals,
+// wildcard actions and a missing secure transport statement
+func checkResourcePolicy(runner tflint.Runner, rule tflint.Rule, attr *hclext.Attribute, service string) error {
+	// Policies built from other resources cannot be inspected
+	if !isStaticExpr(attr.Expr) {
+		return nil
+	}
+
+	var document string
+	if err := runner.EvaluateExpr(attr.Expr, &document, nil); err != nil {
+		return err
+	}
+
+	policy, err := parseIamPolicy(document)
+	if err != nil {
+		runner.EmitIssue(
+			rule,
+			fmt.Sprintf("\"%s\" is not a valid IAM policy document.", attr.Name),
+			attr.Expr.Range(),
+		)
+		return nil
+	}
+
+	wildcardPrincipal, wildcardAction, secureTransport := false, false, false
+	for _, statement := range policy.Statement {
+		if statement.Effect == "Deny" && statement.hasCondition(secureTransportOperators, secureTransportConditionKey, "false") {
+			secureTransport = true
+		}
+		if statement.Effect != "Allow" {
+			continue
+		}
+
+		if statement.Principal.isWildcard() && !statement.hasConditionKey(sourceConditionKeys...) {
+			wildcardPrincipal = true
+		}
+		for _, action := range statement.Action {
+			if action == "*" || strings.EqualFold(action, service+":*") {
+				wildcardAction = true
+			}
+		}
+	}
+
+	if wildcardPrincipal {
+		runner.EmitIssue(
+			rule,
+			fmt.Sprintf("\"%s\" allows Principal \"*\" without a \"%s\" or \"%s\" condition.", attr.Name, sourceConditionKeys[0], sourceConditionKeys[1]),
+			attr.Expr.Range(),
+		)
+	}
+	if wildcardAction {
+		runner.EmitIssue(
+			rule,
+			fmt.Sprintf("\"%s\" should not allow all \"%s:*\" actions.", attr.Name, service),
+			attr.Expr.Range(),
+		)
+	}
+	if !secureTransport {
+		runner.EmitIssue(
+			rule,
+			fmt.Sprintf("\"%s\" does not deny requests without \"%s\".", attr.Name, secureTransportConditionKey),
+			attr.Expr.Range(),
+		)
+	}
+
+	return nil
+}
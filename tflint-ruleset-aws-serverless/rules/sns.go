@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const (
+	snsTopicType             = "aws_sns_topic"
+	snsTopicPolicyType       = "aws_sns_topic_policy"
+	snsTopicSubscriptionType = "aws_sns_topic_subscription"
+	snsFifoSuffix            = ".fifo"
+)
+
+// awsSnsTopic is an "aws_sns_topic" resource with the attributes relevant to
+// other resources publishing or subscribing to it
+type awsSnsTopic struct {
+	resource *hclext.Block
+	// name is the name of the topic, if known
+	name string
+	// fifo is true if the topic is a FIFO topic. fifoKnown is false if
+	// "fifo_topic" cannot be evaluated statically.
+	fifo      bool
+	fifoKnown bool
+	// policies contains the static and dynamic policy attributes of the
+	// topic, both inline and from "aws_sns_topic_policy" resources
+	policies []*hclext.Attribute
+}
+
+// awsSnsTopics returns the "aws_sns_topic" resources by name
+func awsSnsTopics(runner tflint.Runner) (map[string]*awsSnsTopic, error) {
+	topics := make(map[string]*awsSnsTopic)
+
+	resources, err := runner.GetResourceContent(snsTopicType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "name"},
+			{Name: "fifo_topic"},
+			{Name: "policy"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		topic := &awsSnsTopic{resource: resource, fifoKnown: true}
+
+		if attr, ok := resource.Body.Attributes["fifo_topic"]; ok {
+			if isStaticExpr(attr.Expr) {
+				if err := runner.EvaluateExpr(attr.Expr, &topic.fifo, nil); err != nil {
+					return nil, err
+				}
+			} else {
+				topic.fifoKnown = false
+			}
+		}
+		if attr, ok := resource.Body.Attributes["name"]; ok && isStaticExpr(attr.Expr) {
+			if err := runner.EvaluateExpr(attr.Expr, &topic.name, nil); err != nil {
+				return nil, err
+			}
+			if strings.HasSuffix(topic.name, snsFifoSuffix) {
+				topic.fifo = true
+				topic.fifoKnown = true
+			}
+		}
+
+		if attr, ok := resource.Body.Attributes["policy"]; ok {
+			topic.policies = append(topic.policies, attr)
+		}
+
+		topics[resource.Labels[1]] = topic
+	}
+
+	// Policies attached through separate resources
+	resources, err = runner.GetResourceContent(snsTopicPolicyType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "arn"},
+			{Name: "policy"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		arnAttr, ok := resource.Body.Attributes["arn"]
+		if !ok {
+			continue
+		}
+		topicName, ok := resourceReference(arnAttr.Expr, snsTopicType)
+		if !ok {
+			continue
+		}
+		topic, ok := topics[topicName]
+		if !ok {
+			continue
+		}
+
+		if attr, ok := resource.Body.Attributes["policy"]; ok {
+			topic.policies = append(topic.policies, attr)
+		}
+	}
+
+	return topics, nil
+}
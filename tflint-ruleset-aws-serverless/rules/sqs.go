@@ -0,0 +1,486 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	sqsQueueType              = "aws_sqs_queue"
+	sqsQueuePolicyType        = "aws_sqs_queue_policy"
+	sqsQueueRedrivePolicyType = "aws_sqs_queue_redrive_policy"
+	sqsQueueRedriveAllowType  = "aws_sqs_queue_redrive_allow_policy"
+	sqsFifoSuffix             = ".fifo"
+	// sqsDefaultRetention is the default message retention period, in seconds
+	sqsDefaultRetention = 345600
+)
+
+// awsSqsQueue is an "aws_sqs_queue" resource with the attributes relevant to
+// other resources sending messages to it
+type awsSqsQueue struct {
+	resource *hclext.Block
+	// name is the name of the queue, if known
+	name string
+	// fifo is true if the queue is a FIFO queue. fifoKnown is false if
+	// "fifo_queue" cannot be evaluated statically.
+	fifo      bool
+	fifoKnown bool
+	// retention is the message retention period of the queue, in seconds.
+	// retentionKnown is false if it cannot be evaluated statically.
+	retention      int
+	retentionKnown bool
+	// policies contains the static and dynamic policy attributes of the
+	// queue, both inline and from "aws_sqs_queue_policy" resources
+	policies []*hclext.Attribute
+	// redrivePolicies contains the redrive policy attributes of the queue,
+	// both inline and from "aws_sqs_queue_redrive_policy" resources
+	redrivePolicies []*hclext.Attribute
+	// redriveAllowPolicies contains the redrive allow policy attributes of
+	// the queue, both inline and from "aws_sqs_queue_redrive_allow_policy"
+	// resources
+	redriveAllowPolicies []*hclext.Attribute
+}
+
+// allowsServiceSend returns true if a queue policy allows the service principal
+// to send messages. Policies that cannot be evaluated statically are assumed
+// to allow it.
+func (q *awsSqsQueue) allowsServiceSend(runner tflint.Runner, service string) (bool, error) {
+	for _, attr := range q.policies {
+		if !isStaticExpr(attr.Expr) {
+			return true, nil
+		}
+
+		var document string
+		if err := runner.EvaluateExpr(attr.Expr, &document, nil); err != nil {
+			return false, err
+		}
+
+		policy, err := parseIamPolicy(document)
+		if err != nil {
+			continue
+		}
+		if policy.allowsServiceAction(service, "sqs:SendMessage") {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// awsSqsQueues returns the "aws_sqs_queue" resources by name
+func awsSqsQueues(runner tflint.Runner) (map[string]*awsSqsQueue, error) {
+	queues := make(map[string]*awsSqsQueue)
+
+	resources, err := runner.GetResourceContent(sqsQueueType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "name"},
+			{Name: "fifo_queue"},
+			{Name: "message_retention_seconds"},
+			{Name: "policy"},
+			{Name: "redrive_policy"},
+			{Name: "redrive_allow_policy"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		queue := &awsSqsQueue{
+			resource:       resource,
+			fifoKnown:      true,
+			retention:      sqsDefaultRetention,
+			retentionKnown: true,
+		}
+
+		if attr, ok := resource.Body.Attributes["fifo_queue"]; ok {
+			if isStaticExpr(attr.Expr) {
+				if err := runner.EvaluateExpr(attr.Expr, &queue.fifo, nil); err != nil {
+					return nil, err
+				}
+			} else {
+				queue.fifoKnown = false
+			}
+		}
+		if attr, ok := resource.Body.Attributes["name"]; ok && isStaticExpr(attr.Expr) {
+			if err := runner.EvaluateExpr(attr.Expr, &queue.name, nil); err != nil {
+				return nil, err
+			}
+			if strings.HasSuffix(queue.name, sqsFifoSuffix) {
+				queue.fifo = true
+				queue.fifoKnown = true
+			}
+		}
+
+		if attr, ok := resource.Body.Attributes["message_retention_seconds"]; ok {
+			if isStaticExpr(attr.Expr) {
+				if err := runner.EvaluateExpr(attr.Expr, &queue.retention, nil); err != nil {
+					return nil, err
+				}
+			} else {
+				queue.retentionKnown = false
+			}
+		}
+
+		if attr, ok := resource.Body.Attributes["policy"]; ok {
+			queue.policies = append(queue.policies, attr)
+		}
+		if attr, ok := resource.Body.Attributes["redrive_policy"]; ok {
+			queue.redrivePolicies = append(queue.redrivePolicies, attr)
+		}
+		if attr, ok := resource.Body.Attributes["redrive_allow_policy"]; ok {
+			queue.redriveAllowPolicies = append(queue.redriveAllowPolicies, attr)
+		}
+
+		queues[resource.Labels[1]] = queue
+	}
+
+	// Policies attached through separate resources
+	for _, attachment := range []struct {
+		resourceType string
+		attrName     string
+	}{
+		{sqsQueuePolicyType, "policy"},
+		{sqsQueueRedrivePolicyType, "redrive_policy"},
+		{sqsQueueRedriveAllowType, "redrive_allow_policy"},
+	} {
+		resources, err = runner.GetResourceContent(attachment.resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "queue_url"},
+				{Name: attachment.attrName},
+			},
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources.Blocks {
+			urlAttr, ok := resource.Body.Attributes["queue_url"]
+			if !ok {
+				continue
+			}
+			queueName, ok := resourceReference(urlAttr.Expr, sqsQueueType)
+			if !ok {
+				continue
+			}
+			queue, ok := queues[queueName]
+			if !ok {
+				continue
+			}
+
+			attr, ok := resource.Body.Attributes[attachment.attrName]
+			if !ok {
+				continue
+			}
+			switch attachment.resourceType {
+			case sqsQueuePolicyType:
+				queue.policies = append(queue.policies, attr)
+			case sqsQueueRedrivePolicyType:
+				queue.redrivePolicies = append(queue.redrivePolicies, attr)
+			default:
+				queue.redriveAllowPolicies = append(queue.redriveAllowPolicies, attr)
+			}
+		}
+	}
+
+	return queues, nil
+}
+
+// sqsDeadLetterTargets lists the attributes of other resources that contain
+// the ARN of a dead-letter queue, nested in the given blocks
+var sqsDeadLetterTargets = []struct {
+	resourceType string
+	blocks       []string
+	attrName     string
+}{
+	{"aws_cloudwatch_event_target", []string{"dead_letter_config"}, "arn"},
+	{"aws_lambda_function", []string{"dead_letter_config"}, "target_arn"},
+	{"aws_scheduler_schedule", []string{"target", "dead_letter_config"}, "arn"},
+	{"aws_pipes_pipe", []string{"source_parameters", "kinesis_stream_parameters", "dead_letter_config"}, "arn"},
+	{"aws_pipes_pipe", []string{"source_parameters", "dynamodb_stream_parameters", "dead_letter_config"}, "arn"},
+}
+
+// awsSqsDeadLetterQueues returns the queues used as dead-letter queues, by
+// name, with the queues that send messages to them through a redrive policy.
+// Queues used as dead-letter queues by other services have no source queue.
+func awsSqsDeadLetterQueues(runner tflint.Runner, queues map[string]*awsSqsQueue) (map[string][]*awsSqsQueue, error) {
+	deadLetterQueues := make(map[string][]*awsSqsQueue)
+
+	add := func(queue *awsSqsQueue, source *awsSqsQueue) {
+		name := queue.resource.Labels[1]
+		if source != nil {
+			deadLetterQueues[name] = append(deadLetterQueues[name], source)
+		} else if _, ok := deadLetterQueues[name]; !ok {
+			deadLetterQueues[name] = nil
+		}
+	}
+
+	addRedrivePolicy := func(source *awsSqsQueue, attr *hclext.Attribute) error {
+		policy, err := evaluateSqsRedrivePolicy(runner, attr.Expr)
+		if err != nil || policy == nil {
+			return err
+		}
+
+		if queue, ok := policy.deadLetterQueue(queues); ok {
+			add(queue, source)
+		}
+		return nil
+	}
+
+	for _, source := range queues {
+		for _, attr := range source.redrivePolicies {
+			if err := addRedrivePolicy(source, attr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// SNS subscriptions use the same redrive policy format
+	resources, err := runner.GetResourceContent("aws_sns_topic_subscription", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "redrive_policy"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range resources.Blocks {
+		if attr, ok := resource.Body.Attributes["redrive_policy"]; ok {
+			if err := addRedrivePolicy(nil, attr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, target := range sqsDeadLetterTargets {
+		schema := &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: target.attrName}},
+		}
+		for i := len(target.blocks) - 1; i >= 0; i-- {
+			schema = &hclext.BodySchema{
+				Blocks: []hclext.BlockSchema{{Type: target.blocks[i], Body: schema}},
+			}
+		}
+
+		resources, err := runner.GetResourceContent(target.resourceType, schema, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks := resources.Blocks
+		for _, blockType := range target.blocks {
+			var nested hclext.Blocks
+			for _, block := range blocks {
+				nested = append(nested, block.Body.Blocks.OfType(blockType)...)
+			}
+			blocks = nested
+		}
+
+		for _, block := range blocks {
+			attr, ok := block.Body.Attributes[target.attrName]
+			if !ok {
+				continue
+			}
+
+			queue, err := awsSqsQueueByExpr(runner, queues, attr.Expr)
+			if err != nil {
+				return nil, err
+			}
+			if queue != nil {
+				add(queue, nil)
+			}
+		}
+	}
+
+	return deadLetterQueues, nil
+}
+
+// awsSqsQueueByExpr returns the queue referenced by an expression, either as
+// a resource or by a static ARN, or nil if it is not in the configuration
+func awsSqsQueueByExpr(runner tflint.Runner, queues map[string]*awsSqsQueue, expr hcl.Expression) (*awsSqsQueue, error) {
+	if queueName, ok := resourceReference(expr, sqsQueueType); ok {
+		return queues[queueName], nil
+	}
+
+	if !isStaticExpr(expr) {
+		return nil, nil
+	}
+
+	var arn string
+	if err := runner.EvaluateExpr(expr, &arn, nil); err != nil {
+		return nil, err
+	}
+	queue, _ := awsSqsQueueByArn(queues, arn)
+	return queue, nil
+}
+
+// isSqsQueueArn returns true if the value looks like the ARN of an SQS queue
+func isSqsQueueArn(value string) bool {
+	parts := strings.Split(value, ":")
+	return len(parts) == 6 && parts[0] == "arn" && parts[2] == "sqs"
+}
+
+// awsSqsQueueByArn returns the queue with the name of a static queue ARN
+func awsSqsQueueByArn(queues map[string]*awsSqsQueue, arn string) (*awsSqsQueue, bool) {
+	parts := strings.Split(arn, ":")
+	for _, queue := range queues {
+		if queue.name != "" && queue.name == parts[len(parts)-1] {
+			return queue, true
+		}
+	}
+
+	return nil, false
+}
+
+// sqsRedrivePolicy is the content of a redrive policy, written either as a
+// JSON document or with the jsonencode() function
+type sqsRedrivePolicy struct {
+	// parseErr is set if the policy is not a valid JSON document, or if
+	// its values have the wrong type
+	parseErr            error
+	hasDeadLetterTarget bool
+	// deadLetterTargetExpr is the expression of "deadLetterTargetArn" when
+	// the policy uses jsonencode(), nil otherwise
+	deadLetterTargetExpr hcl.Expression
+	// deadLetterTargetArn is the value of "deadLetterTargetArn", if known
+	deadLetterTargetArn string
+	hasMaxReceiveCount  bool
+	// maxReceiveCount is nil if its value is not known
+	maxReceiveCount *int
+}
+
+// deadLetterQueue returns the queue used as dead-letter queue, either
+// referenced as a resource or by its ARN
+func (p *sqsRedrivePolicy) deadLetterQueue(queues map[string]*awsSqsQueue) (*awsSqsQueue, bool) {
+	if p.deadLetterTargetExpr != nil {
+		if queueName, ok := resourceReference(p.deadLetterTargetExpr, sqsQueueType); ok {
+			queue, ok := queues[queueName]
+			return queue, ok
+		}
+	}
+
+	if p.deadLetterTargetArn != "" {
+		return awsSqsQueueByArn(queues, p.deadLetterTargetArn)
+	}
+
+	return nil, false
+}
+
+// parseSqsRedrivePolicy parses a redrive policy JSON document
+func parseSqsRedrivePolicy(document string) (*sqsRedrivePolicy, error) {
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &values); err != nil {
+		return nil, err
+	}
+
+	policy := &sqsRedrivePolicy{}
+
+	if value, ok := values["deadLetterTargetArn"]; ok {
+		policy.hasDeadLetterTarget = true
+		arn, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("\"deadLetterTargetArn\" should be a string")
+		}
+		policy.deadLetterTargetArn = arn
+	}
+
+	if value, ok := values["maxReceiveCount"]; ok {
+		policy.hasMaxReceiveCount = true
+		// SQS accepts both numbers and strings
+		var count int
+		switch value := value.(type) {
+		case float64:
+			if value != math.Trunc(value) {
+				return nil, fmt.Errorf("\"maxReceiveCount\" should be an integer")
+			}
+			count = int(value)
+		case string:
+			var err error
+			if count, err = strconv.Atoi(value); err != nil {
+				return nil, fmt.Errorf("\"maxReceiveCount\" should be an integer")
+			}
+		default:
+			return nil, fmt.Errorf("\"maxReceiveCount\" should be an integer")
+		}
+		policy.maxReceiveCount = &count
+	}
+
+	return policy, nil
+}
+
+// evaluateSqsRedrivePolicy returns the redrive policy of an expression, or
+// nil if the policy cannot be inspected
+func evaluateSqsRedrivePolicy(runner tflint.Runner, expr hcl.Expression) (*sqsRedrivePolicy, error) {
+	if call, ok := expr.(*hclsyntax.FunctionCallExpr); ok && call.Name == "jsonencode" && len(call.Args) == 1 {
+		object, ok := call.Args[0].(*hclsyntax.ObjectConsExpr)
+		if !ok {
+			return nil, nil
+		}
+
+		return sqsRedrivePolicyFromObject(runner, object)
+	}
+
+	if !isStaticExpr(expr) {
+		return nil, nil
+	}
+
+	var document string
+	if err := runner.EvaluateExpr(expr, &document, nil); err != nil {
+		return nil, err
+	}
+
+	policy, err := parseSqsRedrivePolicy(document)
+	if err != nil {
+		return &sqsRedrivePolicy{parseErr: err}, nil
+	}
+	return policy, nil
+}
+
+// sqsRedrivePolicyFromObject returns the redrive policy of the object passed
+// to jsonencode(), evaluating the static values only
+func sqsRedrivePolicyFromObject(runner tflint.Runner, object *hclsyntax.ObjectConsExpr) (*sqsRedrivePolicy, error) {
+	policy := &sqsRedrivePolicy{}
+
+	for _, item := range object.Items {
+		key, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || key.Type() != cty.String {
+			// Dynamic keys could be any of the policy keys
+			return nil, nil
+		}
+
+		switch key.AsString() {
+		case "deadLetterTargetArn":
+			policy.hasDeadLetterTarget = true
+			policy.deadLetterTargetExpr = item.ValueExpr
+			if isStaticExpr(item.ValueExpr) {
+				if err := runner.EvaluateExpr(item.ValueExpr, &policy.deadLetterTargetArn, nil); err != nil {
+					return nil, err
+				}
+			}
+		case "maxReceiveCount":
+			policy.hasMaxReceiveCount = true
+			if isStaticExpr(item.ValueExpr) {
+				var value float64
+				if err := runner.EvaluateExpr(item.ValueExpr, &value, &tflint.EvaluateExprOption{WantType: &cty.Number}); err != nil {
+					return nil, err
+				}
+				if value != math.Trunc(value) {
+					return &sqsRedrivePolicy{parseErr: fmt.Errorf("\"maxReceiveCount\" should be an integer")}, nil
+				}
+				count := int(value)
+				policy.maxReceiveCount = &count
+			}
+		}
+	}
+
+	return policy, nil
+}
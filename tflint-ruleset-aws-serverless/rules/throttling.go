@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	throttlingBurstAttrName = "throttling_burst_limit"
+	throttlingRateAttrName  = "throttling_rate_limit"
+)
+
+// awsThrottlingConfig is the rule configuration for throttling rules. The
+// defaults match the account-level quotas for API Gateway.
+type awsThrottlingConfig struct {
+	MaxBurstLimit float64 `hclext:"max_burst_limit,optional"`
+	MaxRateLimit  float64 `hclext:"max_rate_limit,optional"`
+}
+
+func newAwsThrottlingConfig() *awsThrottlingConfig {
+	return &awsThrottlingConfig{
+		MaxBurstLimit: 5000,
+		MaxRateLimit:  10000,
+	}
+}
+
+// checkThrottlingLimits validates the throttling values of a settings block
+func checkThrottlingLimits(runner tflint.Runner, rule tflint.Rule, body *hclext.BodyContent, config *awsThrottlingConfig) error {
+	limits := make(map[string]float64)
+	maxLimits := map[string]float64{
+		throttlingBurstAttrName: config.MaxBurstLimit,
+		throttlingRateAttrName:  config.MaxRateLimit,
+	}
+
+	for _, attrName := range []string{throttlingBurstAttrName, throttlingRateAttrName} {
+		attr, ok := body.Attributes[attrName]
+		if !ok || !isStaticExpr(attr.Expr) {
+			continue
+		}
+
+		var value float64
+		if err := runner.EvaluateExpr(attr.Expr, &value, &tflint.EvaluateExprOption{WantType: &cty.Number}); err != nil {
+			return err
+		}
+
+		switch {
+		case value == -1:
+			runner.EmitIssue(
+				rule,
+				fmt.Sprintf("\"%s\" should not be -1 (unlimited).", attrName),
+				attr.Expr.Range(),
+			)
+			continue
+		case value < 0:
+			runner.EmitIssue(
+				rule,
+				fmt.Sprintf("\"%s\" should not be negative.", attrName),
+				attr.Expr.Range(),
+			)
+			continue
+		case value > maxLimits[attrName]:
+			runner.EmitIssue(
+				rule,
+				fmt.Sprintf("\"%s\" should not exceed the account-level limit of %v.", attrName, maxLimits[attrName]),
+				attr.Expr.Range(),
+			)
+		}
+
+		limits[attrName] = value
+	}
+
+	burst, burstOk := limits[throttlingBurstAttrName]
+	rate, rateOk := limits[throttlingRateAttrName]
+	if burstOk && rateOk && burst < rate {
+		runner.EmitIssue(
+			rule,
+			fmt.Sprintf("\"%s\" should be greater than or equal to \"%s\".", throttlingBurstAttrName, throttlingRateAttrName),
+			body.Attributes[throttlingBurstAttrName].Expr.Range(),
+		)
+	}
+
+	return nil
+}